@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"strings"
+)
+
+// BackfillCommand is the first argument of the binary, that runs the backfill instead of the server
+const BackfillCommand = "backfill"
+
+// DefaultBackfillConcurrency is the default count of the blocks backfilled at the same time
+const DefaultBackfillConcurrency = 4
+
+// BackfillOptions describes the backfill run - the transactions within the block range, touching any of the
+// addresses, are stored and linked to the user
+type BackfillOptions struct {
+	Chain       string
+	FromBlock   uint64
+	ToBlock     uint64
+	Addresses   []string
+	UserID      int
+	Concurrency int
+}
+
+// ParseBackfillArgs parses the command line arguments of the backfill, e.g.
+// `backfill -from 5700000 -to 5710000 -address 0xAa44...,0x4c16... -concurrency 8`
+func ParseBackfillArgs(args []string) (*BackfillOptions, error) {
+	opts := &BackfillOptions{}
+	var addresses string
+
+	flags := flag.NewFlagSet(BackfillCommand, flag.ContinueOnError)
+	flags.StringVar(&opts.Chain, "chain", "", "chain ID or well-known name, the default chain when empty")
+	flags.Uint64Var(&opts.FromBlock, "from", 0, "first block of the range")
+	flags.Uint64Var(&opts.ToBlock, "to", 0, "last block of the range, the chain head when empty")
+	flags.StringVar(&addresses, "address", "",
+		"comma separated addresses to backfill, the addresses watched by the users when empty")
+	flags.IntVar(&opts.UserID, "user", 0, "user ID the transactions of the provided addresses are linked to")
+	flags.IntVar(&opts.Concurrency, "concurrency", DefaultBackfillConcurrency, "count of blocks backfilled at once")
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	for _, address := range strings.Split(addresses, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			opts.Addresses = append(opts.Addresses, strings.ToLower(address))
+		}
+	}
+
+	// the range may start with the genesis block, so the first block is told apart from its zero default
+	fromProvided := false
+	flags.Visit(func(f *flag.Flag) {
+		fromProvided = fromProvided || f.Name == "from"
+	})
+	if !fromProvided {
+		return nil, errors.New("the first block of the range must be provided")
+	}
+	if opts.ToBlock != 0 && opts.ToBlock < opts.FromBlock {
+		return nil, errors.New("the last block of the range cannot be before the first one")
+	}
+	if opts.Concurrency < 1 {
+		return nil, errors.New("the concurrency must be positive")
+	}
+	if opts.UserID != 0 && len(opts.Addresses) == 0 {
+		return nil, errors.New("the user can be provided only along with the addresses")
+	}
+
+	return opts, nil
+}
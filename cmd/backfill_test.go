@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBackfillArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    *BackfillOptions
+		wantErr bool
+	}{
+		{
+			name: "with the range starting at the genesis block, it is backfilled from there",
+			args: []string{"-from", "0", "-to", "100"},
+			want: &BackfillOptions{FromBlock: 0, ToBlock: 100, Concurrency: DefaultBackfillConcurrency},
+		},
+		{
+			name: "with the addresses, they are lower cased",
+			args: []string{"-from", "5700000", "-address", "0xAa449E0226B45D2044B1f721D04001fDe02ABb08, ", "-user", "1"},
+			want: &BackfillOptions{FromBlock: 5700000, Addresses: []string{"0xaa449e0226b45d2044b1f721d04001fde02abb08"},
+				UserID: 1, Concurrency: DefaultBackfillConcurrency},
+		},
+		{
+			name:    "without the first block, it fails",
+			args:    []string{"-to", "100"},
+			wantErr: true,
+		},
+		{
+			name:    "with the last block before the first one, it fails",
+			args:    []string{"-from", "100", "-to", "99"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := ParseBackfillArgs(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, opts)
+		})
+	}
+}
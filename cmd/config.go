@@ -2,18 +2,62 @@ package cmd
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 const (
-	APIPort           = "APIPort"
-	EthNodeURL        = "EthNodeURL"
-	DBConnectionURL   = "DBConnectionURL"
-	JWTSecret         = "JWTSecret"
-	LogLevel          = "LogLevel"
-	NodeRateLimit     = "NodeRateLimit"
-	DefaultNodeCredit = 10
+	APIPort              = "APIPort"
+	AdminPort            = "AdminPort"
+	EthNodeURL           = "EthNodeURL"
+	ChainNodeURLs        = "ChainNodeURLs"
+	DefaultChainID       = "DefaultChainID"
+	DBConnectionURL      = "DBConnectionURL"
+	StorageDriver        = "StorageDriver"
+	SQLitePath           = "SQLitePath"
+	JWTSecret            = "JWTSecret"
+	LogLevel             = "LogLevel"
+	NodeRateLimit        = "NodeRateLimit"
+	NodeRateBurst        = "NodeRateBurst"
+	NodeMethodCosts      = "NodeMethodCosts"
+	NodeEjectAfter       = "NodeEjectAfter"
+	NodeProbeInterval    = "NodeProbeInterval"
+	NodeBatchSize        = "NodeBatchSize"
+	NodeBatchLinger      = "NodeBatchLinger"
+	NodeCreditModel      = "NodeCreditModel"
+	NodeRetryMaxAttempts = "NodeRetryMaxAttempts"
+	NodeRetryBaseDelay   = "NodeRetryBaseDelay"
+	NodeRetryMaxDelay    = "NodeRetryMaxDelay"
+	NodeRecordDir        = "NodeRecordDir"
+	NodeTraceCalls       = "NodeTraceCalls"
+	NodeVerifyReceipts   = "NodeVerifyReceipts"
+	NodePrewarmBlocks    = "NodePrewarmBlocks"
+	NodeReplayDir        = "NodeReplayDir"
+	PendingRefresh       = "PendingRefresh"
+	ReorgPollInterval    = "ReorgPollInterval"
+	WatchPollInterval    = "WatchPollInterval"
+	ConfirmationDepth    = "ConfirmationDepth"
+	ABISelectorsFile     = "ABISelectorsFile"
+	DefaultNodeCredit    = 10
+
+	DefaultNodeEjectAfter       = 3
+	DefaultNodeProbeInterval    = 10 * time.Second
+	DefaultNodeBatchSize        = 10
+	DefaultNodeBatchLinger      = 10 * time.Millisecond
+	DefaultNodeCreditModel      = "per-call"
+	DefaultNodeRetryMaxAttempts = 4
+	DefaultNodeRetryBaseDelay   = 100 * time.Millisecond
+	DefaultNodeRetryMaxDelay    = 2 * time.Second
+	DefaultPendingRefresh       = 15 * time.Second
+	DefaultReorgPollInterval    = 12 * time.Second
+	DefaultWatchPollInterval    = 12 * time.Second
+	DefaultConfirmationDepth    = 12
+	DefaultABISelectorsFile     = "internal/decoder/selectors.json"
+	StorageDriverPostgres       = "postgres"
+	StorageDriverSQLite         = "sqlite"
+	DefaultSQLitePath           = "ethereum-fetcher.db"
+	SepoliaChainID              = 11155111
 )
 
 // NewViper creates a Viper instance responsible for env variables and default configuration
@@ -22,14 +66,55 @@ func NewViper() *viper.Viper {
 	vp.AutomaticEnv()
 
 	_ = vp.BindEnv(APIPort, "API_PORT")
+	_ = vp.BindEnv(AdminPort, "ADMIN_PORT")
 	_ = vp.BindEnv(EthNodeURL, "ETH_NODE_URL")
 	_ = vp.BindEnv(DBConnectionURL, "DB_CONNECTION_URL")
+	_ = vp.BindEnv(StorageDriver, "STORAGE_DRIVER")
+	_ = vp.BindEnv(SQLitePath, "SQLITE_PATH")
 	_ = vp.BindEnv(JWTSecret, "JWT_SECRET")
 	_ = vp.BindEnv(LogLevel, "LOG_LEVEL")
 	_ = vp.BindEnv(NodeRateLimit, "NODE_RATE_LIMIT_PER_SECOND")
+	_ = vp.BindEnv(NodeRateBurst, "NODE_RATE_BURST")
+	_ = vp.BindEnv(NodeMethodCosts, "NODE_METHOD_COSTS")
+	_ = vp.BindEnv(NodeEjectAfter, "NODE_EJECT_AFTER_FAILURES")
+	_ = vp.BindEnv(NodeProbeInterval, "NODE_PROBE_INTERVAL")
+	_ = vp.BindEnv(NodeBatchSize, "NODE_BATCH_SIZE")
+	_ = vp.BindEnv(NodeBatchLinger, "NODE_BATCH_LINGER")
+	_ = vp.BindEnv(NodeCreditModel, "NODE_CREDIT_MODEL")
+	_ = vp.BindEnv(NodeRetryMaxAttempts, "NODE_RETRY_MAX_ATTEMPTS")
+	_ = vp.BindEnv(NodeRetryBaseDelay, "NODE_RETRY_BASE_DELAY")
+	_ = vp.BindEnv(NodeRetryMaxDelay, "NODE_RETRY_MAX_DELAY")
+	_ = vp.BindEnv(NodeRecordDir, "NODE_RECORD_DIR")
+	_ = vp.BindEnv(NodeTraceCalls, "NODE_TRACE_CALLS")
+	_ = vp.BindEnv(NodeVerifyReceipts, "NODE_VERIFY_RECEIPTS")
+	_ = vp.BindEnv(NodePrewarmBlocks, "NODE_PREWARM_BLOCKS")
+	_ = vp.BindEnv(NodeReplayDir, "NODE_REPLAY_DIR")
+	_ = vp.BindEnv(PendingRefresh, "PENDING_REFRESH_INTERVAL")
+	_ = vp.BindEnv(ChainNodeURLs, "CHAIN_NODE_URLS")
+	_ = vp.BindEnv(DefaultChainID, "DEFAULT_CHAIN_ID")
+	_ = vp.BindEnv(ReorgPollInterval, "REORG_POLL_INTERVAL")
+	_ = vp.BindEnv(WatchPollInterval, "WATCH_POLL_INTERVAL")
+	_ = vp.BindEnv(ConfirmationDepth, "CONFIRMATION_DEPTH")
+	_ = vp.BindEnv(ABISelectorsFile, "ABI_SELECTORS_FILE")
 
 	vp.SetDefault(LogLevel, "info")
+	vp.SetDefault(StorageDriver, StorageDriverPostgres)
+	vp.SetDefault(SQLitePath, DefaultSQLitePath)
 	vp.SetDefault(NodeRateLimit, strconv.Itoa(DefaultNodeCredit))
+	vp.SetDefault(NodeEjectAfter, strconv.Itoa(DefaultNodeEjectAfter))
+	vp.SetDefault(NodeProbeInterval, DefaultNodeProbeInterval.String())
+	vp.SetDefault(NodeBatchSize, strconv.Itoa(DefaultNodeBatchSize))
+	vp.SetDefault(NodeBatchLinger, DefaultNodeBatchLinger.String())
+	vp.SetDefault(NodeCreditModel, DefaultNodeCreditModel)
+	vp.SetDefault(NodeRetryMaxAttempts, strconv.Itoa(DefaultNodeRetryMaxAttempts))
+	vp.SetDefault(NodeRetryBaseDelay, DefaultNodeRetryBaseDelay.String())
+	vp.SetDefault(NodeRetryMaxDelay, DefaultNodeRetryMaxDelay.String())
+	vp.SetDefault(PendingRefresh, DefaultPendingRefresh.String())
+	vp.SetDefault(DefaultChainID, strconv.Itoa(SepoliaChainID))
+	vp.SetDefault(ReorgPollInterval, DefaultReorgPollInterval.String())
+	vp.SetDefault(WatchPollInterval, DefaultWatchPollInterval.String())
+	vp.SetDefault(ConfirmationDepth, strconv.Itoa(DefaultConfirmationDepth))
+	vp.SetDefault(ABISelectorsFile, DefaultABISelectorsFile)
 
 	return vp
 }
@@ -3,13 +3,43 @@ package app
 import (
 	"context"
 
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/decoder"
+	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg/models"
 )
 
 //go:generate mockery --name ServiceProvider
 type ServiceProvider interface {
-	GetUser(username, password string) (*models.User, error)
-	GetTransactionsByHashes(requestCtx context.Context, txHashes []string, userID int) ([]*models.Transaction, error)
-	GetAllTransactions() ([]*models.Transaction, error)
-	GetMyTransactions(userID int) ([]*models.Transaction, error)
+	GetUser(requestCtx context.Context, username, password string) (*models.User, error)
+	ResolveChain(chain string) (int64, error)
+	GetTransactionsByHashes(requestCtx context.Context, chainID int64, txHashes []string, userID int) (
+		[]*models.Transaction, error)
+	GetBlockTransactions(requestCtx context.Context, chainID int64, number uint64) ([]*models.Transaction, error)
+	GetAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter, page store.Page) (
+		[]*models.Transaction, *store.Cursor, error)
+	GetMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter, page store.Page) (
+		[]*models.Transaction, *store.Cursor, error)
+	StreamAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter, fn store.StreamFunc) error
+	StreamMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter,
+		fn store.StreamFunc) error
+	GetBlocks(requestCtx context.Context, chainID int64, blockHashes []string) (map[string]*models.Block, error)
+	GetTransactionLogs(requestCtx context.Context, chainID int64, txHashes []string) (
+		map[string][]*models.TransactionLog, error)
+	GetTransactionTraces(requestCtx context.Context, chainID int64, txHashes []string) (
+		map[string][]*models.TransactionTrace, error)
+	GetTokenTransfers(requestCtx context.Context, chainID int64, txHashes []string) (
+		map[string][]*models.TokenTransfer, error)
+	GetMyTokenTransfers(requestCtx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error)
+	ChainHead(chainID int64) (head, finalized uint64)
+	UploadABI(requestCtx context.Context, chainID int64, address, abiJSON string) ([]string, error)
+	WatchAddress(requestCtx context.Context, chainID int64, userID int, address string) error
+	BroadcastTransaction(requestCtx context.Context, chainID int64, rawTx []byte, userID int) (
+		*models.Transaction, error)
+	DecodeInput(requestCtx context.Context, tx *models.Transaction) *decoder.DecodedInput
+}
+
+// Backfiller stores the past transactions of the block range, it runs instead of the server
+type Backfiller interface {
+	Backfill(opts *cmd.BackfillOptions) error
 }
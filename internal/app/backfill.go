@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrNothingToBackfill is returned when neither addresses are provided, nor any address is watched
+var ErrNothingToBackfill = errors.New("no address to backfill")
+
+// blockOutcome is the result of a single backfilled block
+type blockOutcome struct {
+	number uint64
+	err    error
+}
+
+// Backfill stores the past transactions within the block range, touching the provided addresses (or the addresses
+// watched by the users), the same way the block ingestion does; the progress is checkpointed after each block,
+// so the interrupted run resumes from the first block that is not backfilled yet
+func (ap *Service) Backfill(opts *cmd.BackfillOptions) error {
+	chainID, err := ap.ResolveChain(opts.Chain)
+	if err != nil {
+		return err
+	}
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return err
+	}
+
+	watchers, err := ap.backfillWatchers(chainID, opts)
+	if err != nil {
+		return err
+	}
+
+	job := backfillJob(opts, watchers)
+	checkpoint, err := ap.st.GetBackfillCheckpoint(ap.ctx, chainID, job)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		toBlock := opts.ToBlock
+		if toBlock == 0 {
+			if toBlock, err = net.HeadBlockNumber(ap.ctx); err != nil {
+				return err
+			}
+		}
+		// nolint:gosec // the block numbers fit in int64
+		checkpoint = &models.BackfillCheckpoint{
+			ChainID:   chainID,
+			Job:       job,
+			FromBlock: int64(opts.FromBlock),
+			ToBlock:   int64(toBlock),
+			NextBlock: int64(opts.FromBlock),
+		}
+	} else {
+		log.Infof("resuming backfill of chain %d from block %d", chainID, checkpoint.NextBlock)
+	}
+
+	// nolint:gosec // the checkpoint holds only the block numbers, converted from uint64
+	next, last := uint64(checkpoint.NextBlock), uint64(checkpoint.ToBlock)
+	if next > last {
+		log.Infof("backfill of chain %d for blocks %d-%d is already done", chainID, checkpoint.FromBlock, last)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ap.ctx)
+	defer cancel()
+
+	numbers := make(chan uint64)
+	go func() {
+		defer close(numbers)
+		for number := next; number <= last; number++ {
+			select {
+			case numbers <- number:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	outcomes := make(chan blockOutcome)
+	for range opts.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range numbers {
+				if ctx.Err() != nil {
+					// the run failed or got interrupted, the rest of the blocks are backfilled on resume
+					return
+				}
+				outcomes <- blockOutcome{number: number, err: ap.ingestBlock(ctx, net, number, watchers)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	// the checkpoint moves only over the blocks backfilled without a gap, so no block is skipped on resume
+	done := make(map[uint64]bool)
+	var errList []error
+	for outcome := range outcomes {
+		if outcome.err != nil {
+			errList = append(errList, fmt.Errorf("cannot backfill block %d: %w", outcome.number, outcome.err))
+			cancel()
+			continue
+		}
+		done[outcome.number] = true
+
+		advanced := false
+		for done[next] {
+			delete(done, next)
+			next++
+			advanced = true
+		}
+		if !advanced || len(errList) > 0 {
+			continue
+		}
+
+		// nolint:gosec // the block numbers fit in int64
+		checkpoint.NextBlock = int64(next)
+		if err = ap.st.SaveBackfillCheckpoint(ap.ctx, checkpoint); err != nil {
+			errList = append(errList, err)
+			cancel()
+			continue
+		}
+		log.Infof("backfilled chain %d up to block %d of %d", chainID, next-1, last)
+	}
+
+	return errors.Join(errList...)
+}
+
+// backfillWatchers maps the provided addresses to the user, or the watched addresses to their watchers
+func (ap *Service) backfillWatchers(chainID int64, opts *cmd.BackfillOptions) (map[string][]int, error) {
+	watchers := make(map[string][]int)
+	if len(opts.Addresses) > 0 {
+		for _, address := range opts.Addresses {
+			watchers[strings.ToLower(address)] = []int{opts.UserID}
+		}
+		return watchers, nil
+	}
+
+	watchedList, err := ap.st.GetWatchedAddresses(ap.ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if len(watchedList) == 0 {
+		return nil, ErrNothingToBackfill
+	}
+	for _, watched := range watchedList {
+		watchers[watched.Address] = append(watchers[watched.Address], watched.UserID)
+	}
+	return watchers, nil
+}
+
+// backfillJob identifies the backfill run by its block range and address filter, so the same run resumes
+// from its checkpoint
+func backfillJob(opts *cmd.BackfillOptions, watchers map[string][]int) string {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%d-%d", opts.FromBlock, opts.ToBlock)
+	for _, address := range slices.Sorted(maps.Keys(watchers)) {
+		_, _ = fmt.Fprintf(hash, ";%s=%v", address, slices.Sorted(slices.Values(watchers[address])))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
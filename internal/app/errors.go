@@ -0,0 +1,35 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownChain is returned for chains without configured node
+var ErrUnknownChain = errors.New("unknown chain")
+
+// ErrNodeUnavailable is returned when the ethereum node cannot serve the request, even after retries
+var ErrNodeUnavailable = errors.New("ethereum node is unavailable")
+
+// ErrVerificationFailed is returned when the node data does not match the roots of its block header, so it is
+// not stored
+var ErrVerificationFailed = errors.New("node data failed verification")
+
+// ErrBlockNotFound is returned for the block beyond the chain head
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrInvalidABI is returned when the uploaded contract ABI cannot be parsed
+var ErrInvalidABI = errors.New("invalid contract abi")
+
+// ErrInvalidTransaction is returned when the raw transaction is invalid or rejected by the node
+var ErrInvalidTransaction = errors.New("transaction cannot be broadcast")
+
+// NotFoundError is returned along with the found transactions, when some of the hashes are unknown to the node
+type NotFoundError struct {
+	TxHashes []string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("transactions not found: %s", strings.Join(e.TxHashes, ", "))
+}
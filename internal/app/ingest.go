@@ -0,0 +1,220 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"ethereum-fetcher/internal/network"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxIngestBlocks limits the count of the blocks ingested at once, the rest of them are ingested the next time
+	maxIngestBlocks = 32
+	// maxIngestAttempts limits the attempts to ingest the same block, the block failing all of them is skipped
+	// so the ingestion keeps up with the chain head, and is left to the backfill
+	maxIngestAttempts = 5
+)
+
+// ingestCursor is the progress of the block ingestion - the next block to ingest and its failed attempts so far
+type ingestCursor struct {
+	next     uint64
+	failures int
+}
+
+// WatchAddress registers the address to be watched by the user, its transactions are stored as the user ones
+// once they are mined
+func (ap *Service) WatchAddress(requestCtx context.Context, chainID int64, userID int, address string) error {
+	return ap.st.AddWatchedAddress(requestCtx, &models.WatchedAddress{
+		UserID:  userID,
+		ChainID: chainID,
+		Address: strings.ToLower(address),
+	})
+}
+
+// IngestBlocks follows the new heads of the chain, until the app context is done, and stores the transactions
+// touching the watched addresses, starting with the head at the time it is called. The new heads are pushed by the
+// node connected over websocket, otherwise the chain head is polled; the polling goes on along with the subscription,
+// so the heads missed while it is down are ingested anyway
+func (ap *Service) IngestBlocks(chainID int64, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	heads := make(chan uint64, 1)
+	sub, subscribing := ap.subscribeNewHeads(chainID, heads)
+	defer func() {
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}()
+
+	var cursor ingestCursor
+	for {
+		if err := ap.ingestBlocks(chainID, &cursor); err != nil {
+			log.Errorf("cannot ingest blocks of chain %d: %v", chainID, err)
+		}
+
+		var subErr <-chan error
+		if sub != nil {
+			subErr = sub.Err()
+		}
+
+		select {
+		case <-ticker.C:
+			if sub == nil && subscribing {
+				sub, subscribing = ap.subscribeNewHeads(chainID, heads)
+			}
+		case <-heads:
+		case err := <-subErr:
+			log.Warnf("subscription to new heads of chain %d is broken, polling until it is renewed: %v", chainID, err)
+			sub = nil
+		case <-ap.ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribeNewHeads subscribes to the new heads of the chain, the subscription is not retried when the node does
+// not support it at all, e.g. it is connected over http
+func (ap *Service) subscribeNewHeads(chainID int64, heads chan<- uint64) (network.Subscription, bool) {
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		log.Errorf("cannot subscribe to new heads of chain %d: %v", chainID, err)
+		return nil, false
+	}
+
+	sub, err := net.SubscribeNewHeads(ap.ctx, heads)
+	switch {
+	case errors.Is(err, network.ErrSubscriptionUnsupported):
+		log.Infof("the node of chain %d does not push new heads, they are polled: %v", chainID, err)
+		return nil, false
+	case err != nil:
+		log.Warnf("cannot subscribe to new heads of chain %d, polling until it is renewed: %v", chainID, err)
+		return nil, true
+	}
+	return sub, true
+}
+
+// ingestBlocks ingests the blocks from the cursor up to the chain head and moves the cursor after the last ingested
+// one; the block failing maxIngestAttempts times in a row is skipped, instead of stalling the ingestion
+func (ap *Service) ingestBlocks(chainID int64, cursor *ingestCursor) error {
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return err
+	}
+
+	head, err := net.HeadBlockNumber(ap.ctx)
+	if err != nil {
+		return err
+	}
+	if cursor.next == 0 {
+		cursor.next = head
+	}
+
+	watchedList, err := ap.st.GetWatchedAddresses(ap.ctx, chainID)
+	if err != nil {
+		return err
+	}
+	if len(watchedList) == 0 {
+		// nothing to match, the blocks are skipped without fetching them
+		cursor.next = max(cursor.next, head+1)
+		cursor.failures = 0
+		return nil
+	}
+
+	watchers := make(map[string][]int, len(watchedList))
+	for _, watched := range watchedList {
+		watchers[watched.Address] = append(watchers[watched.Address], watched.UserID)
+	}
+
+	for ingested := 0; cursor.next <= head && ingested < maxIngestBlocks; ingested++ {
+		if err = ap.ingestBlock(ap.ctx, net, cursor.next, watchers); err != nil {
+			if cursor.failures++; cursor.failures < maxIngestAttempts {
+				return fmt.Errorf("cannot ingest block %d (attempt %d of %d): %w", cursor.next, cursor.failures,
+					maxIngestAttempts, err)
+			}
+			log.Errorf("skipping block %d of chain %d after %d failed attempts, backfill it once the cause is "+
+				"fixed (backfill -chain %d -from %d -to %d): %v", cursor.next, chainID, cursor.failures, chainID,
+				cursor.next, cursor.next, err)
+		}
+		cursor.failures = 0
+		cursor.next++
+	}
+
+	return nil
+}
+
+// ingestBlock stores the block transactions touching the watched addresses and links them to the users watching
+// those addresses; their records are built from the block body and all of its receipts, fetched at once
+func (ap *Service) ingestBlock(ctx context.Context, net network.EthereumProvider, number uint64,
+	watchers map[string][]int) error {
+	blockRecords, err := net.BlockTransactions(ctx, number)
+	if err != nil {
+		return err
+	}
+
+	// each transaction is stored at once along with the first of its users, the other ones are linked afterwards
+	records := make(map[int][]*store.TxRecord)
+	others := make(map[int][]*models.Transaction)
+	matched := 0
+	for _, record := range blockRecords {
+		userIDs := watchingUsers(record, watchers)
+		if len(userIDs) == 0 {
+			continue
+		}
+		matched++
+		records[userIDs[0]] = append(records[userIDs[0]], record)
+		for _, userID := range userIDs[1:] {
+			others[userID] = append(others[userID], record.Transaction)
+		}
+	}
+	if matched == 0 {
+		return nil
+	}
+
+	// the watched transactions of the block are stored all or none, so that the block is ingested again on failure
+	err = ap.st.WithTx(ctx, func(tx store.StorageProvider) error {
+		for _, userID := range slices.Sorted(maps.Keys(records)) {
+			if err := tx.InsertTransactions(ctx, records[userID], userID); err != nil {
+				return err
+			}
+		}
+		for _, userID := range slices.Sorted(maps.Keys(others)) {
+			if err := tx.InsertTransactionsUser(ctx, others[userID], userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error storing watched transactions of block %d: %v", number, err)
+	}
+	log.Infof("stored %d watched transactions of block %d", matched, number)
+
+	return nil
+}
+
+// watchingUsers returns the users watching any of the addresses the transaction touches - sent from or to them,
+// creating them or with logs emitted by them
+func watchingUsers(record *store.TxRecord, watchers map[string][]int) []int {
+	addresses := []string{record.FromAddress, record.ToAddress.String, record.ContractAddress.String}
+	for _, txLog := range record.Logs {
+		addresses = append(addresses, txLog.Address)
+	}
+
+	users := make(map[int]struct{})
+	for _, address := range addresses {
+		for _, userID := range watchers[strings.ToLower(address)] {
+			users[userID] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(users))
+}
@@ -4,9 +4,13 @@ package mocks
 
 import (
 	context "context"
-	models "ethereum-fetcher/internal/store/pg/models"
+	decoder "ethereum-fetcher/internal/decoder"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "ethereum-fetcher/internal/store/pg/models"
+
+	store "ethereum-fetcher/internal/store"
 )
 
 // ServiceProvider is an autogenerated mock type for the ServiceProvider type
@@ -14,29 +18,146 @@ type ServiceProvider struct {
 	mock.Mock
 }
 
-// GetAllTransactions provides a mock function with given fields:
-func (_m *ServiceProvider) GetAllTransactions() ([]*models.Transaction, error) {
-	ret := _m.Called()
+// BroadcastTransaction provides a mock function with given fields: requestCtx, chainID, rawTx, userID
+func (_m *ServiceProvider) BroadcastTransaction(requestCtx context.Context, chainID int64, rawTx []byte, userID int) (*models.Transaction, error) {
+	ret := _m.Called(requestCtx, chainID, rawTx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BroadcastTransaction")
+	}
+
+	var r0 *models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, int) (*models.Transaction, error)); ok {
+		return rf(requestCtx, chainID, rawTx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, int) *models.Transaction); ok {
+		r0 = rf(requestCtx, chainID, rawTx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte, int) error); ok {
+		r1 = rf(requestCtx, chainID, rawTx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainHead provides a mock function with given fields: chainID
+func (_m *ServiceProvider) ChainHead(chainID int64) (uint64, uint64) {
+	ret := _m.Called(chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChainHead")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(int64) (uint64, uint64)); ok {
+		return rf(chainID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) uint64); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) uint64); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// DecodeInput provides a mock function with given fields: requestCtx, tx
+func (_m *ServiceProvider) DecodeInput(requestCtx context.Context, tx *models.Transaction) *decoder.DecodedInput {
+	ret := _m.Called(requestCtx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecodeInput")
+	}
+
+	var r0 *decoder.DecodedInput
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Transaction) *decoder.DecodedInput); ok {
+		r0 = rf(requestCtx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*decoder.DecodedInput)
+		}
+	}
+
+	return r0
+}
+
+// GetAllTransactions provides a mock function with given fields: requestCtx, chainID, filter, page
+func (_m *ServiceProvider) GetAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter, page store.Page) ([]*models.Transaction, *store.Cursor, error) {
+	ret := _m.Called(requestCtx, chainID, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTransactions")
 	}
 
+	var r0 []*models.Transaction
+	var r1 *store.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.Page) ([]*models.Transaction, *store.Cursor, error)); ok {
+		return rf(requestCtx, chainID, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.Page) []*models.Transaction); ok {
+		r0 = rf(requestCtx, chainID, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, store.Filter, store.Page) *store.Cursor); ok {
+		r1 = rf(requestCtx, chainID, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*store.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, store.Filter, store.Page) error); ok {
+		r2 = rf(requestCtx, chainID, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBlockTransactions provides a mock function with given fields: requestCtx, chainID, number
+func (_m *ServiceProvider) GetBlockTransactions(requestCtx context.Context, chainID int64, number uint64) ([]*models.Transaction, error) {
+	ret := _m.Called(requestCtx, chainID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockTransactions")
+	}
+
 	var r0 []*models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*models.Transaction, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) ([]*models.Transaction, error)); ok {
+		return rf(requestCtx, chainID, number)
 	}
-	if rf, ok := ret.Get(0).(func() []*models.Transaction); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) []*models.Transaction); ok {
+		r0 = rf(requestCtx, chainID, number)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(requestCtx, chainID, number)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,29 +165,188 @@ func (_m *ServiceProvider) GetAllTransactions() ([]*models.Transaction, error) {
 	return r0, r1
 }
 
-// GetMyTransactions provides a mock function with given fields: userID
-func (_m *ServiceProvider) GetMyTransactions(userID int) ([]*models.Transaction, error) {
-	ret := _m.Called(userID)
+// GetBlocks provides a mock function with given fields: requestCtx, chainID, blockHashes
+func (_m *ServiceProvider) GetBlocks(requestCtx context.Context, chainID int64, blockHashes []string) (map[string]*models.Block, error) {
+	ret := _m.Called(requestCtx, chainID, blockHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocks")
+	}
+
+	var r0 map[string]*models.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) (map[string]*models.Block, error)); ok {
+		return rf(requestCtx, chainID, blockHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) map[string]*models.Block); ok {
+		r0 = rf(requestCtx, chainID, blockHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(requestCtx, chainID, blockHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyTokenTransfers provides a mock function with given fields: requestCtx, chainID, userID
+func (_m *ServiceProvider) GetMyTokenTransfers(requestCtx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error) {
+	ret := _m.Called(requestCtx, chainID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyTokenTransfers")
+	}
+
+	var r0 []*models.TokenTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*models.TokenTransfer, error)); ok {
+		return rf(requestCtx, chainID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*models.TokenTransfer); ok {
+		r0 = rf(requestCtx, chainID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TokenTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(requestCtx, chainID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyTransactions provides a mock function with given fields: requestCtx, chainID, userID, filter, page
+func (_m *ServiceProvider) GetMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter, page store.Page) ([]*models.Transaction, *store.Cursor, error) {
+	ret := _m.Called(requestCtx, chainID, userID, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMyTransactions")
 	}
 
 	var r0 []*models.Transaction
-	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]*models.Transaction, error)); ok {
-		return rf(userID)
+	var r1 *store.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.Page) ([]*models.Transaction, *store.Cursor, error)); ok {
+		return rf(requestCtx, chainID, userID, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(int) []*models.Transaction); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.Page) []*models.Transaction); ok {
+		r0 = rf(requestCtx, chainID, userID, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, store.Filter, store.Page) *store.Cursor); ok {
+		r1 = rf(requestCtx, chainID, userID, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*store.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int, store.Filter, store.Page) error); ok {
+		r2 = rf(requestCtx, chainID, userID, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTokenTransfers provides a mock function with given fields: requestCtx, chainID, txHashes
+func (_m *ServiceProvider) GetTokenTransfers(requestCtx context.Context, chainID int64, txHashes []string) (map[string][]*models.TokenTransfer, error) {
+	ret := _m.Called(requestCtx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenTransfers")
+	}
+
+	var r0 map[string][]*models.TokenTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) (map[string][]*models.TokenTransfer, error)); ok {
+		return rf(requestCtx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) map[string][]*models.TokenTransfer); ok {
+		r0 = rf(requestCtx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*models.TokenTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(requestCtx, chainID, txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionLogs provides a mock function with given fields: requestCtx, chainID, txHashes
+func (_m *ServiceProvider) GetTransactionLogs(requestCtx context.Context, chainID int64, txHashes []string) (map[string][]*models.TransactionLog, error) {
+	ret := _m.Called(requestCtx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionLogs")
+	}
+
+	var r0 map[string][]*models.TransactionLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) (map[string][]*models.TransactionLog, error)); ok {
+		return rf(requestCtx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) map[string][]*models.TransactionLog); ok {
+		r0 = rf(requestCtx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*models.TransactionLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(requestCtx, chainID, txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionTraces provides a mock function with given fields: requestCtx, chainID, txHashes
+func (_m *ServiceProvider) GetTransactionTraces(requestCtx context.Context, chainID int64, txHashes []string) (map[string][]*models.TransactionTrace, error) {
+	ret := _m.Called(requestCtx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionTraces")
+	}
+
+	var r0 map[string][]*models.TransactionTrace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) (map[string][]*models.TransactionTrace, error)); ok {
+		return rf(requestCtx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) map[string][]*models.TransactionTrace); ok {
+		r0 = rf(requestCtx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*models.TransactionTrace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(requestCtx, chainID, txHashes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,9 +354,9 @@ func (_m *ServiceProvider) GetMyTransactions(userID int) ([]*models.Transaction,
 	return r0, r1
 }
 
-// GetTransactionsByHashes provides a mock function with given fields: requestCtx, txHashes, userID
-func (_m *ServiceProvider) GetTransactionsByHashes(requestCtx context.Context, txHashes []string, userID int) ([]*models.Transaction, error) {
-	ret := _m.Called(requestCtx, txHashes, userID)
+// GetTransactionsByHashes provides a mock function with given fields: requestCtx, chainID, txHashes, userID
+func (_m *ServiceProvider) GetTransactionsByHashes(requestCtx context.Context, chainID int64, txHashes []string, userID int) ([]*models.Transaction, error) {
+	ret := _m.Called(requestCtx, chainID, txHashes, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionsByHashes")
@@ -84,19 +364,19 @@ func (_m *ServiceProvider) GetTransactionsByHashes(requestCtx context.Context, t
 
 	var r0 []*models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string, int) ([]*models.Transaction, error)); ok {
-		return rf(requestCtx, txHashes, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, int) ([]*models.Transaction, error)); ok {
+		return rf(requestCtx, chainID, txHashes, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string, int) []*models.Transaction); ok {
-		r0 = rf(requestCtx, txHashes, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, int) []*models.Transaction); ok {
+		r0 = rf(requestCtx, chainID, txHashes, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string, int) error); ok {
-		r1 = rf(requestCtx, txHashes, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string, int) error); ok {
+		r1 = rf(requestCtx, chainID, txHashes, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,9 +384,9 @@ func (_m *ServiceProvider) GetTransactionsByHashes(requestCtx context.Context, t
 	return r0, r1
 }
 
-// GetUser provides a mock function with given fields: username, password
-func (_m *ServiceProvider) GetUser(username string, password string) (*models.User, error) {
-	ret := _m.Called(username, password)
+// GetUser provides a mock function with given fields: requestCtx, username, password
+func (_m *ServiceProvider) GetUser(requestCtx context.Context, username string, password string) (*models.User, error) {
+	ret := _m.Called(requestCtx, username, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUser")
@@ -114,19 +394,19 @@ func (_m *ServiceProvider) GetUser(username string, password string) (*models.Us
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*models.User, error)); ok {
-		return rf(username, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.User, error)); ok {
+		return rf(requestCtx, username, password)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *models.User); ok {
-		r0 = rf(username, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.User); ok {
+		r0 = rf(requestCtx, username, password)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(username, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(requestCtx, username, password)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -134,6 +414,118 @@ func (_m *ServiceProvider) GetUser(username string, password string) (*models.Us
 	return r0, r1
 }
 
+// ResolveChain provides a mock function with given fields: chain
+func (_m *ServiceProvider) ResolveChain(chain string) (int64, error) {
+	ret := _m.Called(chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveChain")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(chain)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(chain)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAllTransactions provides a mock function with given fields: requestCtx, chainID, filter, fn
+func (_m *ServiceProvider) StreamAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter, fn store.StreamFunc) error {
+	ret := _m.Called(requestCtx, chainID, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAllTransactions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.StreamFunc) error); ok {
+		r0 = rf(requestCtx, chainID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamMyTransactions provides a mock function with given fields: requestCtx, chainID, userID, filter, fn
+func (_m *ServiceProvider) StreamMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter, fn store.StreamFunc) error {
+	ret := _m.Called(requestCtx, chainID, userID, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamMyTransactions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.StreamFunc) error); ok {
+		r0 = rf(requestCtx, chainID, userID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadABI provides a mock function with given fields: requestCtx, chainID, address, abiJSON
+func (_m *ServiceProvider) UploadABI(requestCtx context.Context, chainID int64, address string, abiJSON string) ([]string, error) {
+	ret := _m.Called(requestCtx, chainID, address, abiJSON)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadABI")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) ([]string, error)); ok {
+		return rf(requestCtx, chainID, address, abiJSON)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) []string); ok {
+		r0 = rf(requestCtx, chainID, address, abiJSON)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(requestCtx, chainID, address, abiJSON)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchAddress provides a mock function with given fields: requestCtx, chainID, userID, address
+func (_m *ServiceProvider) WatchAddress(requestCtx context.Context, chainID int64, userID int, address string) error {
+	ret := _m.Called(requestCtx, chainID, userID, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, string) error); ok {
+		r0 = rf(requestCtx, chainID, userID, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewServiceProvider creates a new instance of ServiceProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServiceProvider(t interface {
@@ -0,0 +1,198 @@
+package app
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/network"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// chainHead is the most recent state of the chain, as seen by the reorg watcher
+type chainHead struct {
+	mu        sync.RWMutex
+	head      uint64
+	finalized uint64
+}
+
+// ChainHead returns the numbers of the head and the finalized blocks of the chain, both are zero until
+// the reorg watcher sees the chain for the first time
+func (ap *Service) ChainHead(chainID int64) (head, finalized uint64) {
+	chain := ap.chainHead(chainID)
+	chain.mu.RLock()
+	defer chain.mu.RUnlock()
+	return chain.head, chain.finalized
+}
+
+func (ap *Service) setChainHead(chainID int64, head, finalized uint64) {
+	chain := ap.chainHead(chainID)
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	chain.head, chain.finalized = head, finalized
+}
+
+func (ap *Service) chainHead(chainID int64) *chainHead {
+	ap.headsMu.Lock()
+	defer ap.headsMu.Unlock()
+
+	chain, found := ap.heads[chainID]
+	if !found {
+		chain = new(chainHead)
+		ap.heads[chainID] = chain
+	}
+	return chain
+}
+
+// WatchReorgs follows the new heads of the chain, until the app context is done, and re-validates the stored
+// transactions that are not confirmed yet against the canonical chain
+func (ap *Service) WatchReorgs(chainID int64, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := ap.checkReorgs(chainID); err != nil {
+			log.Errorf("cannot check for reorganizations of chain %d: %v", chainID, err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ap.ctx.Done():
+			return
+		}
+	}
+}
+
+func (ap *Service) checkReorgs(chainID int64) error {
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return err
+	}
+
+	head, err := net.HeadBlockNumber(ap.ctx)
+	if err != nil {
+		return err
+	}
+
+	depth := ap.vp.GetUint64(cmd.ConfirmationDepth)
+	confirmed := head - min(head, depth)
+
+	finalized, err := net.FinalizedBlockNumber(ap.ctx)
+	if err != nil {
+		// not every chain supports the finalized block tag, the confirmation depth is used instead
+		log.Debugf("cannot fetch finalized block, falling back to confirmation depth: %v", err)
+		finalized = confirmed
+	}
+
+	prevHead, _ := ap.ChainHead(chainID)
+	ap.setChainHead(chainID, head, finalized)
+	if head == prevHead {
+		return nil
+	}
+
+	txList, err := ap.st.GetTransactionsSinceBlock(ap.ctx, chainID, confirmed)
+	if err != nil {
+		return err
+	}
+
+	// compare each transaction with the canonical block at its height, fetched once per height
+	canonical := make(map[uint64]string)
+	var orphaned []*models.Transaction
+	for _, tx := range txList {
+		number := blockNumber(tx)
+
+		blockHash, found := canonical[number]
+		if !found {
+			blockHash, err = net.BlockHashByNumber(ap.ctx, number)
+			if network.IsNotFound(err) {
+				// the node is behind the stored transaction, check it the next time
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			canonical[number] = blockHash
+		}
+
+		if !strings.EqualFold(blockHash, tx.BlockHash.String) {
+			orphaned = append(orphaned, tx)
+		}
+	}
+
+	return ap.revalidateTransactions(net, orphaned)
+}
+
+// revalidateTransactions re-fetches the orphaned transactions, the ones unknown to the node anymore
+// are invalidated (stored as pending, without logs and traces) until they are seen again
+func (ap *Service) revalidateTransactions(net network.EthereumProvider, txList []*models.Transaction) error {
+	resultChans := make([]<-chan network.TxResult, 0, len(txList))
+	for _, tx := range txList {
+		log.Warnf("transaction '%s' got reorged out of block %s", tx.TXHash, tx.BlockHash.String)
+
+		resultChan, err := net.ScheduleTask(ap.ctx, tx.TXHash)
+		if err != nil {
+			return fmt.Errorf("error scheduling task for hash '%s': %v", tx.TXHash, err)
+		}
+		resultChans = append(resultChans, resultChan)
+	}
+
+	for i, resultChan := range resultChans {
+		result := <-resultChan
+		switch {
+		case result.Err == nil:
+			// already included in another block or back in the mempool; unless re-traced, the call frames of the
+			// orphaned execution are dropped
+			if result.Tx.Pending || result.Tx.Traces == nil {
+				result.Tx.Traces = []*models.TransactionTrace{}
+			}
+		case network.IsNotFound(result.Err):
+			// the call frames of the orphaned execution are dropped along with its logs
+			result.Tx = &store.TxRecord{Transaction: invalidated(txList[i]), Traces: []*models.TransactionTrace{}}
+		default:
+			return fmt.Errorf("error fetching task for hash '%s': %v", txList[i].TXHash, result.Err)
+		}
+
+		if err := ap.st.InsertTransactions(ap.ctx, []*store.TxRecord{result.Tx}, store.NonAuthenticatedUser); err != nil {
+			return fmt.Errorf("error storing info for hash '%s': %v", result.Tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// invalidated drops the block data of the orphaned transaction
+func invalidated(tx *models.Transaction) *models.Transaction {
+	orphan := *tx
+	orphan.R = nil
+	orphan.Pending = true
+	orphan.TXStatus = null.Int{}
+	orphan.BlockHash = null.String{}
+	orphan.BlockNumber = types.NullDecimal{}
+	orphan.ContractAddress = null.String{}
+	orphan.LogsCount = 0
+	orphan.GasUsed = null.Int64{}
+	orphan.EffectiveGasPrice = null.String{}
+	orphan.BlobGasUsed = null.Int64{}
+	orphan.BlobGasPrice = null.String{}
+	// the block it got verified against is not canonical anymore
+	orphan.Verified = false
+	return &orphan
+}
+
+// blockNumber returns the block number of the mined transaction
+func blockNumber(tx *models.Transaction) uint64 {
+	if tx.BlockNumber.Big == nil {
+		return 0
+	}
+	number := new(big.Int)
+	tx.BlockNumber.Int(number)
+	return number.Uint64()
+}
@@ -2,65 +2,229 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"ethereum-fetcher/internal/decoder"
 	"ethereum-fetcher/internal/network"
 	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg/models"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
 type Service struct {
-	ctx context.Context
-	vp  *viper.Viper
-	st  store.StorageProvider
-	net network.EthereumProvider
+	ctx     context.Context
+	vp      *viper.Viper
+	st      store.StorageProvider
+	chains  network.ChainsProvider
+	decoder decoder.InputDecoder
+	headsMu sync.Mutex
+	heads   map[int64]*chainHead
 }
 
-func NewService(ctx context.Context, vp *viper.Viper, st store.StorageProvider, net network.EthereumProvider) *Service {
+func NewService(ctx context.Context, vp *viper.Viper, st store.StorageProvider, chains network.ChainsProvider,
+	dec decoder.InputDecoder) *Service {
 	return &Service{
-		ctx: ctx,
-		vp:  vp,
-		st:  st,
-		net: net,
+		ctx:     ctx,
+		vp:      vp,
+		st:      st,
+		chains:  chains,
+		decoder: dec,
+		heads:   make(map[int64]*chainHead),
 	}
 }
 
 // GetUser get txDB by the provided username and password
-func (ap *Service) GetUser(username, password string) (*models.User, error) {
-	user, err := ap.st.GetUser(username, password)
+func (ap *Service) GetUser(requestCtx context.Context, username, password string) (*models.User, error) {
+	user, err := ap.st.GetUser(requestCtx, username, password)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-// GetAllTransactions fetches all stored txs in the database
-func (ap *Service) GetAllTransactions() ([]*models.Transaction, error) {
-	txList, err := ap.st.GetAllTransactions()
+// ResolveChain returns the ID of the configured chain by its ID or well-known name, the empty chain
+// stands for the default one
+func (ap *Service) ResolveChain(chain string) (int64, error) {
+	if chain == "" {
+		return ap.chains.DefaultChainID(), nil
+	}
+
+	chainID, err := network.ParseChainID(chain)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownChain, chain)
+	}
+	if _, err = ap.chains.Chain(chainID); err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownChain, chain)
+	}
+
+	return chainID, nil
+}
+
+// GetAllTransactions fetches the page of stored txs of the chain in the database, that pass the filter,
+// along with the cursor of the next page, which is nil on the last page
+func (ap *Service) GetAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter,
+	page store.Page) ([]*models.Transaction, *store.Cursor, error) {
+	txList, err := ap.st.GetAllTransactions(requestCtx, chainID, filter, lookAhead(page))
+	if err != nil {
+		return nil, nil, err
+	}
+	txList, next := nextPage(txList, page)
+	return txList, next, nil
+}
+
+// GetMyTransactions fetches the page of my stored txs of the chain in the database, that pass the filter,
+// along with the cursor of the next page, which is nil on the last page
+func (ap *Service) GetMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter,
+	page store.Page) ([]*models.Transaction, *store.Cursor, error) {
+	txList, err := ap.st.GetMyTransactions(requestCtx, chainID, userID, filter, lookAhead(page))
+	if err != nil {
+		return nil, nil, err
+	}
+	txList, next := nextPage(txList, page)
+	return txList, next, nil
+}
+
+// StreamAllTransactions streams all stored txs of the chain in the database, that pass the filter
+func (ap *Service) StreamAllTransactions(requestCtx context.Context, chainID int64, filter store.Filter,
+	fn store.StreamFunc) error {
+	return ap.st.StreamAllTransactions(requestCtx, chainID, filter, fn)
+}
+
+// StreamMyTransactions streams all of my stored txs of the chain in the database, that pass the filter
+func (ap *Service) StreamMyTransactions(requestCtx context.Context, chainID int64, userID int, filter store.Filter,
+	fn store.StreamFunc) error {
+	return ap.st.StreamMyTransactions(requestCtx, chainID, userID, filter, fn)
+}
+
+// lookAhead extends the limited page by one transaction, which tells whether there is a next page
+func lookAhead(page store.Page) store.Page {
+	if page.Limit > 0 {
+		page.Limit++
+	}
+	return page
+}
+
+// nextPage cuts the look ahead transaction off the page and returns the cursor of the next page, if any
+func nextPage(txList []*models.Transaction, page store.Page) ([]*models.Transaction, *store.Cursor) {
+	if page.Limit == 0 || len(txList) <= page.Limit {
+		return txList, nil
+	}
+	txList = txList[:page.Limit]
+	return txList, store.NewCursor(txList[page.Limit-1])
+}
+
+// GetBlocks fetches the stored blocks of the chain, mapped by block hash
+func (ap *Service) GetBlocks(requestCtx context.Context, chainID int64, blockHashes []string) (
+	map[string]*models.Block, error) {
+	blockList, err := ap.st.GetBlocks(requestCtx, chainID, blockHashes)
 	if err != nil {
 		return nil, err
 	}
-	return txList, nil
+
+	blockMap := make(map[string]*models.Block, len(blockList))
+	for _, block := range blockList {
+		blockMap[block.BlockHash] = block
+	}
+	return blockMap, nil
 }
 
-// GetMyTransactions fetches all of my stored txs in the database
-func (ap *Service) GetMyTransactions(userID int) ([]*models.Transaction, error) {
-	txList, err := ap.st.GetMyTransactions(userID)
+// GetTransactionLogs fetches the stored receipt logs of the chain transactions, grouped by tx hash
+func (ap *Service) GetTransactionLogs(requestCtx context.Context, chainID int64, txHashes []string) (
+	map[string][]*models.TransactionLog, error) {
+	logList, err := ap.st.GetTransactionLogs(requestCtx, chainID, txHashes)
 	if err != nil {
 		return nil, err
 	}
-	return txList, nil
+
+	logMap := make(map[string][]*models.TransactionLog, len(txHashes))
+	for _, txLog := range logList {
+		logMap[txLog.TXHash] = append(logMap[txLog.TXHash], txLog)
+	}
+	return logMap, nil
 }
 
-// GetTransactionsByHashes fetches all stored txs in the database by txHashes
-func (ap *Service) GetTransactionsByHashes(requestCtx context.Context, txHashes []string, userID int) (
-	[]*models.Transaction, error) {
+// GetTransactionTraces fetches the stored call frames of the chain transactions, grouped by tx hash
+func (ap *Service) GetTransactionTraces(requestCtx context.Context, chainID int64, txHashes []string) (
+	map[string][]*models.TransactionTrace, error) {
+	traceList, err := ap.st.GetTransactionTraces(requestCtx, chainID, txHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	traceMap := make(map[string][]*models.TransactionTrace, len(txHashes))
+	for _, trace := range traceList {
+		traceMap[trace.TXHash] = append(traceMap[trace.TXHash], trace)
+	}
+	return traceMap, nil
+}
+
+// GetTokenTransfers fetches the stored token transfers of the chain transactions, grouped by tx hash
+func (ap *Service) GetTokenTransfers(requestCtx context.Context, chainID int64, txHashes []string) (
+	map[string][]*models.TokenTransfer, error) {
+	transferList, err := ap.st.GetTokenTransfers(requestCtx, chainID, txHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	transferMap := make(map[string][]*models.TokenTransfer, len(txHashes))
+	for _, transfer := range transferList {
+		transferMap[transfer.TXHash] = append(transferMap[transfer.TXHash], transfer)
+	}
+	return transferMap, nil
+}
+
+// GetMyTokenTransfers fetches the token transfers of all my stored txs of the chain
+func (ap *Service) GetMyTokenTransfers(requestCtx context.Context, chainID int64, userID int) (
+	[]*models.TokenTransfer, error) {
+	transferList, err := ap.st.GetMyTokenTransfers(requestCtx, chainID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return transferList, nil
+}
+
+// UploadABI registers the ABI of the contract on the chain and returns the signatures of its methods
+func (ap *Service) UploadABI(requestCtx context.Context, chainID int64, address, abiJSON string) ([]string, error) {
+	signatures, err := ap.decoder.RegisterABI(requestCtx, chainID, address, abiJSON)
+	if errors.Is(err, decoder.ErrInvalidABI) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidABI, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return signatures, nil
+}
+
+// DecodeInput decodes the input of the transaction, it returns nil for the contract deployments and the
+// calls of unknown methods
+func (ap *Service) DecodeInput(requestCtx context.Context, tx *models.Transaction) *decoder.DecodedInput {
+	if !tx.ToAddress.Valid {
+		return nil
+	}
+	return ap.decoder.DecodeInput(requestCtx, tx.ChainID, tx.ToAddress.String, tx.Input)
+}
+
+// GetTransactionsByHashes fetches all stored txs in the database by txHashes, the missing ones are fetched
+// from the node; hashes unknown to the node are reported with *NotFoundError, along with the found transactions
+func (ap *Service) GetTransactionsByHashes(requestCtx context.Context, chainID int64, txHashes []string,
+	userID int) ([]*models.Transaction, error) {
 	fullList := make([]*models.Transaction, 0, len(txHashes))
 
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownChain, err)
+	}
+
+	muxCtx, cancel := MergeContexts(ap.ctx, requestCtx)
+	defer cancel()
+
 	// fetch stored transactions
-	txList, err := ap.st.GetTransactionsByHashes(txHashes, userID)
+	txList, err := ap.st.GetTransactionsByHashes(muxCtx, chainID, txHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -69,21 +233,26 @@ func (ap *Service) GetTransactionsByHashes(requestCtx context.Context, txHashes
 	availableMap := make(map[string]*models.Transaction, len(txList))
 	for _, tx := range txList {
 		availableMap[tx.TXHash] = tx
+	}
 
-		// ensure user_transactions table is up-to-date
-		if err := ap.st.InsertTransactionsUser([]*models.Transaction{tx}, userID); err != nil {
-			return nil, fmt.Errorf("error storing info for hash '%s': %v", tx.TXHash, err)
+	// ensure user_transactions table is up-to-date
+	if len(txList) > 0 {
+		if err := ap.st.InsertTransactionsUser(muxCtx, txList, userID); err != nil {
+			return nil, fmt.Errorf("error storing info for stored transactions: %v", err)
 		}
 	}
 
-	muxCtx, cancel := MergeContexts(ap.ctx, requestCtx)
-	defer cancel()
-
-	// schedule tasks for missing transactions
+	// schedule tasks for missing transactions, only once per hash
 	var resultChans []<-chan network.TxResult
+	scheduled := make(map[string]struct{}, len(txHashes))
 	for _, hash := range txHashes {
 		if _, found := availableMap[hash]; !found {
-			resultChan, err := ap.net.ScheduleTask(muxCtx, hash)
+			if _, found = scheduled[hash]; found {
+				continue
+			}
+			scheduled[hash] = struct{}{}
+
+			resultChan, err := net.ScheduleTask(muxCtx, hash)
 			if err != nil {
 				return nil, fmt.Errorf("error scheduling task for hash '%s': %v", hash, err)
 			}
@@ -91,25 +260,51 @@ func (ap *Service) GetTransactionsByHashes(requestCtx context.Context, txHashes
 		}
 	}
 
-	// process scheduled tasks
+	// process scheduled tasks, the fetched transactions are stored all at once
+	var notFound []string
+	var fetched, siblings []*store.TxRecord
 	for i := 0; i < len(resultChans); i++ {
 		select {
 		case result := <-resultChans[i]:
 			if result.Err != nil {
+				if network.IsNotFound(result.Err) {
+					// unknown hashes are reported separately, they don't fail the whole request
+					notFound = append(notFound, result.Tx.TXHash)
+					continue
+				}
+				if errors.Is(result.Err, network.ErrTransient) {
+					return nil, fmt.Errorf("%w: error fetching task for hash '%s': %v",
+						ErrNodeUnavailable, result.Tx.TXHash, result.Err)
+				}
+				if errors.Is(result.Err, network.ErrVerificationFailed) {
+					return nil, fmt.Errorf("%w: error fetching task for hash '%s': %v",
+						ErrVerificationFailed, result.Tx.TXHash, result.Err)
+				}
 				return nil, fmt.Errorf("error fetching task for hash '%s': %v", result.Tx.TXHash, result.Err)
 			}
-			availableMap[result.Tx.TXHash] = result.Tx
-			txList = append(txList, result.Tx)
-
-			// insert newly fetched transactions
-			if err := ap.st.InsertTransactions([]*models.Transaction{result.Tx}, userID); err != nil {
-				return nil, fmt.Errorf("error storing info for hash '%s': %v", result.Tx.TXHash, err)
-			}
+			availableMap[result.Tx.TXHash] = result.Tx.Transaction
+			fetched = append(fetched, result.Tx)
+			siblings = append(siblings, result.Siblings...)
 		case <-muxCtx.Done():
 			return nil, muxCtx.Err()
 		}
 	}
 
+	// insert newly fetched transactions along with their logs
+	if len(fetched) > 0 {
+		if err := ap.st.InsertTransactions(muxCtx, fetched, userID); err != nil {
+			return nil, fmt.Errorf("error storing info for %d fetched transactions: %v", len(fetched), err)
+		}
+	}
+
+	// the pre-warmed transactions of the same blocks are not the user ones, failing to store them is not
+	// a failure of the request
+	if len(siblings) > 0 {
+		if err := ap.st.InsertTransactions(muxCtx, siblings, store.NonAuthenticatedUser); err != nil {
+			log.Warnf("cannot pre-warm %d block transactions: %v", len(siblings), err)
+		}
+	}
+
 	// rebuild the result list in the original order of txHashes
 	for _, hash := range txHashes {
 		if tx, found := availableMap[hash]; found {
@@ -117,9 +312,153 @@ func (ap *Service) GetTransactionsByHashes(requestCtx context.Context, txHashes
 		}
 	}
 
+	if len(notFound) > 0 {
+		return fullList, &NotFoundError{TxHashes: notFound}
+	}
+
 	return fullList, nil
 }
 
+// GetBlockTransactions fetches all the transactions of the canonical block at the provided height and stores them,
+// in the order of the block
+func (ap *Service) GetBlockTransactions(requestCtx context.Context, chainID int64, number uint64) (
+	[]*models.Transaction, error) {
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownChain, err)
+	}
+
+	muxCtx, cancel := MergeContexts(ap.ctx, requestCtx)
+	defer cancel()
+
+	records, err := net.BlockTransactions(muxCtx, number)
+	switch {
+	case network.IsNotFound(err):
+		return nil, fmt.Errorf("%w: %v", ErrBlockNotFound, err)
+	case errors.Is(err, network.ErrTransient):
+		return nil, fmt.Errorf("%w: %v", ErrNodeUnavailable, err)
+	case errors.Is(err, network.ErrVerificationFailed):
+		return nil, fmt.Errorf("%w: %v", ErrVerificationFailed, err)
+	case err != nil:
+		return nil, err
+	}
+
+	if err = ap.st.InsertTransactions(muxCtx, records, store.NonAuthenticatedUser); err != nil {
+		return nil, fmt.Errorf("error storing transactions of block %d: %v", number, err)
+	}
+
+	txList := make([]*models.Transaction, 0, len(records))
+	for _, record := range records {
+		txList = append(txList, record.Transaction)
+	}
+	return txList, nil
+}
+
+// BroadcastTransaction broadcasts the signed raw transaction and stores it as pending transaction of the user,
+// it is tracked until inclusion by the refresh of the pending transactions
+func (ap *Service) BroadcastTransaction(requestCtx context.Context, chainID int64, rawTx []byte, userID int) (
+	*models.Transaction, error) {
+	net, err := ap.chains.Chain(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownChain, err)
+	}
+
+	muxCtx, cancel := MergeContexts(ap.ctx, requestCtx)
+	defer cancel()
+
+	record, err := net.SendRawTransaction(muxCtx, rawTx)
+	switch {
+	case errors.Is(err, network.ErrInvalidTransaction), errors.Is(err, network.ErrTransactionRejected):
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	case errors.Is(err, network.ErrTransient):
+		return nil, fmt.Errorf("%w: %v", ErrNodeUnavailable, err)
+	case err != nil:
+		return nil, err
+	}
+
+	// the broadcast transaction is stored even when the client is gone, since it is tracked from the store only;
+	// it might be already stored, e.g. broadcast before, so its stored state is kept
+	stored := record.Transaction
+	err = ap.st.WithTx(ap.ctx, func(tx store.StorageProvider) error {
+		txList, err := tx.GetTransactionsByHashes(ap.ctx, chainID, []string{record.TXHash})
+		if err != nil {
+			return err
+		}
+		if len(txList) > 0 {
+			stored = txList[0]
+			return tx.InsertTransactionsUser(ap.ctx, txList, userID)
+		}
+		return tx.InsertTransactions(ap.ctx, []*store.TxRecord{record}, userID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error storing info for hash '%s': %v", record.TXHash, err)
+	}
+	log.Infof("broadcast transaction '%s' of chain %d", record.TXHash, chainID)
+	return stored, nil
+}
+
+// RefreshPendingTransactions polls the node for the receipts of the pending transactions, until the app
+// context is done, and upgrades the stored ones once they are mined
+func (ap *Service) RefreshPendingTransactions(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := ap.refreshPendingTransactions(); err != nil {
+				log.Errorf("cannot refresh pending transactions: %v", err)
+			}
+		case <-ap.ctx.Done():
+			return
+		}
+	}
+}
+
+func (ap *Service) refreshPendingTransactions() error {
+	txList, err := ap.st.GetPendingTransactions(ap.ctx)
+	if err != nil {
+		return err
+	}
+
+	resultChans := make([]<-chan network.TxResult, 0, len(txList))
+	for _, tx := range txList {
+		net, err := ap.chains.Chain(tx.ChainID)
+		if err != nil {
+			// the chain is not configured anymore
+			log.Debugf("cannot refresh pending transaction '%s': %v", tx.TXHash, err)
+			continue
+		}
+
+		resultChan, err := net.ScheduleTask(ap.ctx, tx.TXHash)
+		if err != nil {
+			return fmt.Errorf("error scheduling task for hash '%s': %v", tx.TXHash, err)
+		}
+		resultChans = append(resultChans, resultChan)
+	}
+
+	for _, resultChan := range resultChans {
+		result := <-resultChan
+		if result.Err != nil {
+			// the transaction might have been dropped or replaced, try again the next time
+			log.Debugf("cannot refresh pending transaction '%s': %v", result.Tx.TXHash, result.Err)
+			continue
+		}
+		if result.Tx.Pending {
+			continue
+		}
+
+		// upsert the mined transaction, the users already attached to it are kept
+		err := ap.st.InsertTransactions(ap.ctx, []*store.TxRecord{result.Tx}, store.NonAuthenticatedUser)
+		if err != nil {
+			return fmt.Errorf("error storing info for hash '%s': %v", result.Tx.TXHash, err)
+		}
+		log.Infof("pending transaction '%s' got mined", result.Tx.TXHash)
+	}
+
+	return nil
+}
+
 // MergeContexts provides single context by merging the app context (Ctrl+C handler) and
 // http request Context (connection close);
 // This goroutine will exit once the request completes (or being canceled);
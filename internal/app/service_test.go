@@ -3,16 +3,20 @@ package app
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"ethereum-fetcher/cmd"
 	"ethereum-fetcher/internal/network"
 	netmocks "ethereum-fetcher/internal/network/mocks"
+	"ethereum-fetcher/internal/store"
 	storagemocks "ethereum-fetcher/internal/store/mocks"
 	"ethereum-fetcher/internal/store/pg/models"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/ericlagergren/decimal"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -105,11 +109,11 @@ func (s *ServiceTestSuite) TestGetUser() {
 			st := storagemocks.NewStorageProvider(s.T())
 			net := netmocks.NewEthereumProvider(s.T())
 
-			st.On("GetUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			st.On("GetUser", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
 				Return(tt.mockData.user, tt.mockData.err)
-			appService := NewService(s.ctx, s.vp, st, net)
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
 
-			freshUser, err := appService.GetUser(tt.args.user.Username, tt.args.user.Password)
+			freshUser, err := appService.GetUser(s.ctx, tt.args.user.Username, tt.args.user.Password)
 			if !tt.wantErr {
 				assert.Nil(t, err)
 
@@ -180,6 +184,20 @@ func (s *ServiceTestSuite) TestGetTransactionsByHashes() {
 			want:    txList,
 			wantErr: false,
 		},
+		{
+			name: "with provided list of duplicated tx hashes, it fetches each hash from net only once, by user",
+			args: args{
+				txHashes: []string{txList[0].TXHash, txList[1].TXHash, txList[0].TXHash},
+				userID:   2,
+			},
+			mockData: args{
+				txDB:  []*models.Transaction{},
+				netDB: txList,
+				errDB: nil,
+			},
+			want:    []*models.Transaction{txList[0], txList[1], txList[0]},
+			wantErr: false,
+		},
 		{
 			name: "with provided list of tx hashes, it fails to fetch txs from net, by user",
 			args: args{
@@ -229,40 +247,40 @@ func (s *ServiceTestSuite) TestGetTransactionsByHashes() {
 			st := storagemocks.NewStorageProvider(s.T())
 			net := netmocks.NewEthereumProvider(s.T())
 
-			st.On("GetTransactionsByHashes", mock.AnythingOfType("[]string"), mock.AnythingOfType("int")).
+			st.On("GetTransactionsByHashes", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
 				Return(tt.mockData.txDB, tt.mockData.errDB)
-			st.On("InsertTransactionsUser", mock.AnythingOfType("[]*models.Transaction"), mock.AnythingOfType("int")).
+			st.On("InsertTransactionsUser", mock.Anything, mock.AnythingOfType("[]*models.Transaction"), mock.AnythingOfType("int")).
 				Return(tt.mockData.errDB).Maybe()
 
 			if tt.mockData.netDB != nil {
 				resChan1 := make(chan network.TxResult, 1)
-				resChan1 <- network.TxResult{Tx: tt.mockData.netDB[0], Err: tt.mockData.errDB}
+				resChan1 <- network.TxResult{Tx: &store.TxRecord{Transaction: tt.mockData.netDB[0]}, Err: tt.mockData.errDB}
 				close(resChan1)
 				resChan2 := make(chan network.TxResult, 1)
-				resChan2 <- network.TxResult{Tx: tt.mockData.netDB[1], Err: tt.mockData.errDB}
+				resChan2 <- network.TxResult{Tx: &store.TxRecord{Transaction: tt.mockData.netDB[1]}, Err: tt.mockData.errDB}
 				close(resChan2)
 
 				net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), mock.AnythingOfType("string")).Once().Return(chanToChan(resChan1), tt.mockData.errDB)
 				net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), mock.AnythingOfType("string")).Once().Return(chanToChan(resChan2), tt.mockData.errDB)
 
 				if !tt.wantErr {
-					st.On("InsertTransactions", mock.Anything, mock.Anything).
+					st.On("InsertTransactions", mock.Anything, mock.Anything, mock.Anything).
 						Return(tt.mockData.errDB)
 				}
 			} else if tt.mockData.errNet != nil {
 				resChan1 := make(chan network.TxResult, 1)
-				resChan1 <- network.TxResult{Tx: &models.Transaction{TXHash: tt.args.txHashes[0]}, Err: tt.mockData.errNet}
+				resChan1 <- network.TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: tt.args.txHashes[0]}}, Err: tt.mockData.errNet}
 				close(resChan1)
 				resChan2 := make(chan network.TxResult, 1)
-				resChan2 <- network.TxResult{Tx: &models.Transaction{TXHash: tt.args.txHashes[1]}, Err: tt.mockData.errNet}
+				resChan2 <- network.TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: tt.args.txHashes[1]}}, Err: tt.mockData.errNet}
 				close(resChan2)
 				net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), mock.AnythingOfType("string")).Once().Return(chanToChan(resChan1), tt.mockData.errDB)
 				net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), mock.AnythingOfType("string")).Once().Return(chanToChan(resChan2), tt.mockData.errDB)
 			}
 
-			appService := NewService(s.ctx, s.vp, st, net)
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
 
-			freshTxs, err := appService.GetTransactionsByHashes(s.ctx, tt.args.txHashes, tt.args.userID)
+			freshTxs, err := appService.GetTransactionsByHashes(s.ctx, cmd.SepoliaChainID, tt.args.txHashes, tt.args.userID)
 			if !tt.wantErr {
 				assert.Nil(t, err)
 
@@ -277,6 +295,632 @@ func (s *ServiceTestSuite) TestGetTransactionsByHashes() {
 	}
 }
 
+func (s *ServiceTestSuite) TestGetTransactionsByHashesNodeErrors() {
+	t := s.T()
+
+	txList := mockEthereumTransactions()
+
+	tests := []struct {
+		name         string
+		errNet       error
+		want         []*models.Transaction
+		wantNotFound []string
+		wantErr      error
+	}{
+		{
+			name:         "with hash unknown to the node, it returns the found transactions and the missing hash",
+			errNet:       fmt.Errorf("failed to fetch transaction details: %w", network.ErrNotFound),
+			want:         []*models.Transaction{txList[0]},
+			wantNotFound: []string{txList[1].TXHash},
+		},
+		{
+			name:    "with node failing transiently, it returns node unavailable error",
+			errNet:  fmt.Errorf("failed to fetch transaction details: %w", network.ErrTransient),
+			wantErr: ErrNodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := storagemocks.NewStorageProvider(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			st.On("GetTransactionsByHashes", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return([]*models.Transaction{}, nil)
+			st.On("InsertTransactions", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			resChan1 := make(chan network.TxResult, 1)
+			resChan1 <- network.TxResult{Tx: &store.TxRecord{Transaction: txList[0]}}
+			close(resChan1)
+			resChan2 := make(chan network.TxResult, 1)
+			resChan2 <- network.TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: txList[1].TXHash}}, Err: tt.errNet}
+			close(resChan2)
+			net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), txList[0].TXHash).
+				Return(chanToChan(resChan1), nil)
+			net.On("ScheduleTask", mock.AnythingOfType("*context.cancelCtx"), txList[1].TXHash).
+				Return(chanToChan(resChan2), nil)
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+			freshTxs, err := appService.GetTransactionsByHashes(s.ctx, cmd.SepoliaChainID,
+				[]string{txList[0].TXHash, txList[1].TXHash}, 2)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, freshTxs)
+				return
+			}
+
+			var notFoundErr *NotFoundError
+			assert.ErrorAs(t, err, &notFoundErr)
+			assert.Equal(t, tt.wantNotFound, notFoundErr.TxHashes)
+			assert.Equal(t, tt.want, freshTxs)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestRefreshPendingTransactions() {
+	r := s.Require()
+
+	txList := mockEthereumTransactions()
+
+	st := storagemocks.NewStorageProvider(s.T())
+	net := netmocks.NewEthereumProvider(s.T())
+
+	st.On("GetPendingTransactions", mock.Anything, mock.Anything).Return([]*models.Transaction{
+		{ChainID: cmd.SepoliaChainID, TXHash: txList[0].TXHash, Pending: true},
+		{ChainID: cmd.SepoliaChainID, TXHash: txList[1].TXHash, Pending: true},
+	}, nil)
+
+	// the first one got mined, while the second one is still pending
+	resChan1 := make(chan network.TxResult, 1)
+	resChan1 <- network.TxResult{Tx: &store.TxRecord{Transaction: txList[0]}}
+	close(resChan1)
+	resChan2 := make(chan network.TxResult, 1)
+	resChan2 <- network.TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: txList[1].TXHash, Pending: true}}}
+	close(resChan2)
+	net.On("ScheduleTask", mock.Anything, txList[0].TXHash).Return(chanToChan(resChan1), nil)
+	net.On("ScheduleTask", mock.Anything, txList[1].TXHash).Return(chanToChan(resChan2), nil)
+
+	st.On("InsertTransactions", mock.Anything, []*store.TxRecord{{Transaction: txList[0]}}, store.NonAuthenticatedUser).Return(nil).Once()
+
+	appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+	r.NoError(appService.refreshPendingTransactions())
+}
+
+func (s *ServiceTestSuite) TestCheckReorgs() {
+	t := s.T()
+
+	tests := []struct {
+		name         string
+		canonical    string
+		result       network.TxResult
+		wantPending  bool
+		wantRefetch  bool
+		wantFinalErr error
+	}{
+		{
+			name:      "with transaction in canonical block, it is kept",
+			canonical: "0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577",
+		},
+		{
+			name:        "with transaction reorged into another block, it is re-fetched",
+			canonical:   "0x9999999999999999999999999999999999999999999999999999999999999999",
+			wantRefetch: true,
+		},
+		{
+			name:        "with transaction reorged back into the mempool, it is re-fetched as pending",
+			canonical:   "0x9999999999999999999999999999999999999999999999999999999999999999",
+			wantRefetch: true,
+			wantPending: true,
+		},
+		{
+			name:         "with transaction dropped by the reorg, it is invalidated",
+			canonical:    "0x9999999999999999999999999999999999999999999999999999999999999999",
+			result:       network.TxResult{Err: fmt.Errorf("failed to fetch transaction details: %w", network.ErrNotFound)},
+			wantRefetch:  true,
+			wantPending:  true,
+			wantFinalErr: fmt.Errorf("finalized block tag is not supported"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txList := mockEthereumTransactions()
+			txList[0].Verified = true
+
+			st := storagemocks.NewStorageProvider(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			net.On("HeadBlockNumber", mock.Anything).Return(uint64(5703605), nil)
+			net.On("FinalizedBlockNumber", mock.Anything).Return(uint64(5703500), tt.wantFinalErr)
+			st.On("GetTransactionsSinceBlock", mock.Anything, int64(cmd.SepoliaChainID), uint64(5703605-12)).Return([]*models.Transaction{txList[0]}, nil)
+			net.On("BlockHashByNumber", mock.Anything, uint64(5703601)).Return(tt.canonical, nil)
+
+			if tt.wantRefetch {
+				result := tt.result
+				if result.Err == nil {
+					tx := mockEthereumTransactions()[0]
+					if tt.wantPending {
+						tx.Pending = true
+						tx.TXStatus = null.Int{}
+						tx.BlockHash = null.String{}
+						tx.BlockNumber = types.NullDecimal{}
+					}
+					result.Tx = &store.TxRecord{Transaction: tx}
+				} else {
+					result.Tx = &store.TxRecord{Transaction: &models.Transaction{TXHash: txList[0].TXHash}}
+				}
+				resChan := make(chan network.TxResult, 1)
+				resChan <- result
+				close(resChan)
+				net.On("ScheduleTask", mock.Anything, txList[0].TXHash).Return(chanToChan(resChan), nil)
+
+				st.On("InsertTransactions", mock.Anything, mock.MatchedBy(func(txs []*store.TxRecord) bool {
+					// the invalidated transaction is not verified against any canonical block anymore, and the
+					// stored call frames of the orphaned execution are dropped
+					return len(txs) == 1 && txs[0].Pending == tt.wantPending && txs[0].BlockHash.Valid != tt.wantPending &&
+						!(tt.wantPending && txs[0].Verified) && txs[0].Traces != nil && len(txs[0].Traces) == 0
+				}), store.NonAuthenticatedUser).Return(nil).Once()
+			}
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+			assert.NoError(t, appService.checkReorgs(cmd.SepoliaChainID))
+
+			head, finalized := appService.ChainHead(cmd.SepoliaChainID)
+			assert.Equal(t, uint64(5703605), head)
+			if tt.wantFinalErr != nil {
+				assert.Equal(t, uint64(5703605-12), finalized, "confirmation depth must be used instead")
+			} else {
+				assert.Equal(t, uint64(5703500), finalized)
+			}
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestIngestBlocks() {
+	t := s.T()
+
+	recipient := "0xaa449e0226b45d2044b1f721d04001fde02abb08"
+	token := "0x4c16d8c078ef6b56700c1be19a336915962df072"
+
+	tests := []struct {
+		name        string
+		watched     []*models.WatchedAddress
+		wantWatched map[string][]int
+	}{
+		{
+			name: "without watched addresses, the blocks are skipped",
+		},
+		{
+			name: "with watched addresses, the matching transactions are stored for their watchers",
+			watched: []*models.WatchedAddress{
+				{UserID: 2, ChainID: cmd.SepoliaChainID, Address: recipient},
+				{UserID: 1, ChainID: cmd.SepoliaChainID, Address: recipient},
+				{UserID: 3, ChainID: cmd.SepoliaChainID, Address: token},
+			},
+			wantWatched: map[string][]int{
+				"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111": {1, 2},
+				"0x33333f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df73333": {3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := mockStorage(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			net.On("HeadBlockNumber", mock.Anything).Return(uint64(5703605), nil)
+			st.On("GetWatchedAddresses", mock.Anything, int64(cmd.SepoliaChainID)).Return(tt.watched, nil)
+
+			if len(tt.watched) > 0 {
+				net.On("BlockTransactions", mock.Anything, uint64(5703605)).Return([]*store.TxRecord{
+					mockBlockRecord("0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+						"0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
+					mockBlockRecord("0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+						"0xd5e6f34bbd4251195c03e7bf3660677ed2315f70"),
+					mockBlockRecord("0x33333f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df73333",
+						"0xd5e6f34bbd4251195c03e7bf3660677ed2315f70", "0x4C16D8C078eF6B56700C1BE19A336915962df072"),
+				}, nil)
+			}
+
+			for txHash, userIDs := range tt.wantWatched {
+				st.On("InsertTransactions", mock.Anything, mock.MatchedBy(func(txs []*store.TxRecord) bool {
+					return len(txs) == 1 && txs[0].TXHash == txHash
+				}), userIDs[0]).Return(nil).Once()
+				for _, userID := range userIDs[1:] {
+					st.On("InsertTransactionsUser", mock.Anything, mock.MatchedBy(func(txs []*models.Transaction) bool {
+						return len(txs) == 1 && txs[0].TXHash == txHash
+					}), userID).Return(nil).Once()
+				}
+			}
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+			var cursor ingestCursor
+			assert.NoError(t, appService.ingestBlocks(cmd.SepoliaChainID, &cursor))
+			assert.Equal(t, uint64(5703606), cursor.next, "the ingestion must continue after the head")
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestIngestBlocksSkipsFailingBlock() {
+	r := s.Require()
+
+	st := mockStorage(s.T())
+	net := netmocks.NewEthereumProvider(s.T())
+
+	net.On("HeadBlockNumber", mock.Anything).Return(uint64(5703606), nil)
+	st.On("GetWatchedAddresses", mock.Anything, int64(cmd.SepoliaChainID)).Return([]*models.WatchedAddress{
+		{UserID: 1, ChainID: cmd.SepoliaChainID, Address: "0xaa449e0226b45d2044b1f721d04001fde02abb08"},
+	}, nil)
+	net.On("BlockTransactions", mock.Anything, uint64(5703605)).Return(nil,
+		fmt.Errorf("block 5703605: %w", network.ErrVerificationFailed)).Times(maxIngestAttempts)
+	net.On("BlockTransactions", mock.Anything, uint64(5703606)).Return([]*store.TxRecord{}, nil).Once()
+
+	appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+	// the failing block is retried, without moving on to the next one
+	cursor := ingestCursor{next: 5703605}
+	for attempt := 1; attempt < maxIngestAttempts; attempt++ {
+		r.ErrorIs(appService.ingestBlocks(cmd.SepoliaChainID, &cursor), network.ErrVerificationFailed)
+		r.Equal(uint64(5703605), cursor.next, "the failing block must be retried")
+	}
+
+	// until it runs out of the attempts, then it is skipped so the ingestion keeps up with the chain head
+	r.NoError(appService.ingestBlocks(cmd.SepoliaChainID, &cursor))
+	r.Equal(ingestCursor{next: 5703607}, cursor, "the failing block must be skipped")
+}
+
+func (s *ServiceTestSuite) TestIngestBlocksOnNewHead() {
+	r := s.Require()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	st := mockStorage(s.T())
+	net := netmocks.NewEthereumProvider(s.T())
+
+	// the new head is pushed right after subscribing, long before the polling
+	net.On("SubscribeNewHeads", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, heads chan<- uint64) (network.Subscription, error) {
+			heads <- 5703606
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				<-quit
+				return nil
+			}), nil
+		}).Once()
+	net.On("HeadBlockNumber", mock.Anything).Return(uint64(5703605), nil).Once()
+	net.On("HeadBlockNumber", mock.Anything).Return(uint64(5703606), nil).Once()
+	st.On("GetWatchedAddresses", mock.Anything, int64(cmd.SepoliaChainID)).Return([]*models.WatchedAddress{
+		{UserID: 1, ChainID: cmd.SepoliaChainID, Address: "0xaa449e0226b45d2044b1f721d04001fde02abb08"},
+	}, nil)
+	net.On("BlockTransactions", mock.Anything, uint64(5703605)).Return(nil, nil).Once()
+	net.On("BlockTransactions", mock.Anything, uint64(5703606)).Run(func(mock.Arguments) { cancel() }).
+		Return(nil, nil).Once()
+
+	appService := NewService(ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		appService.IngestBlocks(cmd.SepoliaChainID, time.Hour)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		r.FailNow("the pushed head must be ingested without waiting for the polling")
+	}
+}
+
+func (s *ServiceTestSuite) TestBackfill() {
+	t := s.T()
+
+	address := "0xaa449e0226b45d2044b1f721d04001fde02abb08"
+	errNodeFailure := fmt.Errorf("failed to fetch block 101")
+	matchedHash := "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111"
+
+	tests := []struct {
+		name           string
+		opts           *cmd.BackfillOptions
+		watched        []*models.WatchedAddress
+		checkpoint     *models.BackfillCheckpoint
+		blocks         []uint64
+		failedBlock    uint64
+		wantCheckpoint int64
+		wantErr        error
+	}{
+		{
+			name:    "without addresses and watched addresses, there is nothing to backfill",
+			opts:    &cmd.BackfillOptions{FromBlock: 101, ToBlock: 104, Concurrency: 2},
+			wantErr: ErrNothingToBackfill,
+		},
+		{
+			name: "the interrupted backfill resumes from its checkpoint",
+			opts: &cmd.BackfillOptions{FromBlock: 100, ToBlock: 104, Addresses: []string{address}, UserID: 5,
+				Concurrency: 2},
+			checkpoint:     &models.BackfillCheckpoint{ChainID: cmd.SepoliaChainID, FromBlock: 100, ToBlock: 104, NextBlock: 102},
+			blocks:         []uint64{102, 103, 104},
+			wantCheckpoint: 105,
+		},
+		{
+			name: "the watched addresses are backfilled from the first block",
+			opts: &cmd.BackfillOptions{FromBlock: 101, ToBlock: 103, Concurrency: 3},
+			watched: []*models.WatchedAddress{
+				{UserID: 5, ChainID: cmd.SepoliaChainID, Address: address},
+			},
+			blocks:         []uint64{101, 102, 103},
+			wantCheckpoint: 104,
+		},
+		{
+			name: "the failed block stops the checkpoint before it",
+			opts: &cmd.BackfillOptions{FromBlock: 101, ToBlock: 101, Addresses: []string{address}, UserID: 5,
+				Concurrency: 1},
+			failedBlock: 101,
+			wantErr:     errNodeFailure,
+		},
+		{
+			name: "the finished backfill is not repeated",
+			opts: &cmd.BackfillOptions{FromBlock: 100, ToBlock: 104, Addresses: []string{address}, UserID: 5,
+				Concurrency: 2},
+			checkpoint: &models.BackfillCheckpoint{ChainID: cmd.SepoliaChainID, FromBlock: 100, ToBlock: 104, NextBlock: 105},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := mockStorage(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			if len(tt.opts.Addresses) == 0 {
+				st.On("GetWatchedAddresses", mock.Anything, int64(cmd.SepoliaChainID)).Return(tt.watched, nil)
+			}
+			if tt.wantErr != ErrNothingToBackfill {
+				st.On("GetBackfillCheckpoint", mock.Anything, int64(cmd.SepoliaChainID), mock.Anything).Return(tt.checkpoint, nil)
+			}
+
+			for _, number := range tt.blocks {
+				var records []*store.TxRecord
+				if number == 103 {
+					// the only block with the transaction of the address
+					records = append(records, mockBlockRecord(matchedHash, address))
+				}
+				records = append(records, mockBlockRecord(fmt.Sprintf("0x%064x", number),
+					"0xd5e6f34bbd4251195c03e7bf3660677ed2315f70"))
+				net.On("BlockTransactions", mock.Anything, number).Return(records, nil).Once()
+			}
+			if tt.failedBlock != 0 {
+				net.On("BlockTransactions", mock.Anything, tt.failedBlock).Return(nil, errNodeFailure).Once()
+			}
+			if slices.Contains(tt.blocks, 103) {
+				st.On("InsertTransactions", mock.Anything, mock.MatchedBy(func(txs []*store.TxRecord) bool {
+					return len(txs) == 1 && txs[0].TXHash == matchedHash
+				}), 5).Return(nil).Once()
+			}
+
+			var saved []int64
+			if len(tt.blocks) > 0 {
+				st.On("SaveBackfillCheckpoint", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					saved = append(saved, args.Get(1).(*models.BackfillCheckpoint).NextBlock)
+				}).Return(nil)
+			}
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+			err := appService.Backfill(tt.opts)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantCheckpoint != 0 {
+				assert.True(t, slices.IsSorted(saved), "the checkpoint must only move forward")
+				assert.Equal(t, tt.wantCheckpoint, saved[len(saved)-1])
+			} else {
+				assert.Empty(t, saved, "the checkpoint must not move")
+			}
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestBroadcastTransaction() {
+	t := s.T()
+
+	rawTx := []byte{0x02, 0xf8, 0x6f}
+	txHash := "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111"
+	record := &store.TxRecord{Transaction: &models.Transaction{ChainID: cmd.SepoliaChainID, TXHash: txHash,
+		Pending: true}}
+
+	tests := []struct {
+		name      string
+		sendErr   error
+		stored    []*models.Transaction
+		wantStore bool
+		wantErr   error
+	}{
+		{
+			name:      "with new transaction, it is stored as pending transaction of the user",
+			wantStore: true,
+		},
+		{
+			name: "with already stored transaction, it is only linked to the user",
+			stored: []*models.Transaction{
+				{ChainID: cmd.SepoliaChainID, TXHash: txHash, TXStatus: null.IntFrom(1)},
+			},
+		},
+		{
+			name:    "with invalid transaction, it fails with invalid transaction",
+			sendErr: fmt.Errorf("%w: chain ID 1 does not match the chain 11155111", network.ErrInvalidTransaction),
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "with transaction refused by the node, it fails with invalid transaction",
+			sendErr: fmt.Errorf("%w: nonce too low", network.ErrTransactionRejected),
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "with unavailable node, it fails with unavailable node",
+			sendErr: fmt.Errorf("failed to broadcast transaction: %w", network.ErrTransient),
+			wantErr: ErrNodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := mockStorage(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			if tt.sendErr != nil {
+				net.On("SendRawTransaction", mock.Anything, rawTx).Return(nil, tt.sendErr)
+			} else {
+				net.On("SendRawTransaction", mock.Anything, rawTx).Return(record, nil)
+				st.On("GetTransactionsByHashes", mock.Anything, int64(cmd.SepoliaChainID), []string{txHash}).Return(tt.stored, nil)
+			}
+			if tt.wantStore {
+				st.On("InsertTransactions", mock.Anything, []*store.TxRecord{record}, 7).Return(nil)
+			} else if len(tt.stored) > 0 {
+				st.On("InsertTransactionsUser", mock.Anything, tt.stored, 7).Return(nil)
+			}
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+			tx, err := appService.BroadcastTransaction(context.Background(), cmd.SepoliaChainID, rawTx, 7)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, txHash, tx.TXHash)
+			assert.Equal(t, len(tt.stored) == 0, tx.Pending, "the stored state of the transaction must be kept")
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestGetBlockTransactions() {
+	t := s.T()
+
+	records := []*store.TxRecord{
+		{Transaction: &models.Transaction{ChainID: cmd.SepoliaChainID,
+			TXHash: "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111"}},
+		{Transaction: &models.Transaction{ChainID: cmd.SepoliaChainID,
+			TXHash: "0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222"}},
+	}
+
+	tests := []struct {
+		name     string
+		fetchErr error
+		wantErr  error
+	}{
+		{
+			name: "with known block, its transactions are stored and returned in the order of the block",
+		},
+		{
+			name:     "with block beyond the head, it fails with block not found",
+			fetchErr: fmt.Errorf("failed to fetch block 5703602: %w", network.ErrNotFound),
+			wantErr:  ErrBlockNotFound,
+		},
+		{
+			name:     "with unavailable node, it fails with unavailable node",
+			fetchErr: fmt.Errorf("failed to fetch block 5703601: %w", network.ErrTransient),
+			wantErr:  ErrNodeUnavailable,
+		},
+		{
+			name:     "with node data failing the verification, it fails with verification failed",
+			fetchErr: fmt.Errorf("%w: receipts root mismatch", network.ErrVerificationFailed),
+			wantErr:  ErrVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := storagemocks.NewStorageProvider(s.T())
+			net := netmocks.NewEthereumProvider(s.T())
+
+			if tt.fetchErr != nil {
+				net.On("BlockTransactions", mock.Anything, uint64(5703601)).Return(nil, tt.fetchErr)
+			} else {
+				net.On("BlockTransactions", mock.Anything, uint64(5703601)).Return(records, nil)
+				st.On("InsertTransactions", mock.Anything, records, store.NonAuthenticatedUser).Return(nil)
+			}
+
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
+
+			txList, err := appService.GetBlockTransactions(context.Background(), cmd.SepoliaChainID, 5703601)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []*models.Transaction{records[0].Transaction, records[1].Transaction}, txList)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestResolveChain() {
+	t := s.T()
+
+	tests := []struct {
+		name    string
+		chain   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "without chain, it returns the default chain", chain: "", want: cmd.SepoliaChainID},
+		{name: "with chain ID, it returns the chain", chain: "11155111", want: cmd.SepoliaChainID},
+		{name: "with chain name, it returns the chain", chain: "sepolia", want: cmd.SepoliaChainID},
+		{name: "with chain without node, it returns unknown chain error", chain: "mainnet", wantErr: true},
+		{name: "with invalid chain, it returns unknown chain error", chain: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := storagemocks.NewStorageProvider(s.T())
+			chains := mockChains(s.T(), netmocks.NewEthereumProvider(s.T()))
+			chains.On("Chain", mock.AnythingOfType("int64")).Return(nil, network.ErrUnknownChain).Maybe()
+
+			appService := NewService(s.ctx, s.vp, st, chains, nil)
+
+			chainID, err := appService.ResolveChain(tt.chain)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownChain)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, chainID)
+		})
+	}
+}
+
+// mockChains provides the network mock as the provider of the default chain
+func mockChains(t *testing.T, net network.EthereumProvider) *netmocks.ChainsProvider {
+	chains := netmocks.NewChainsProvider(t)
+	chains.On("Chain", int64(cmd.SepoliaChainID)).Return(net, nil).Maybe()
+	chains.On("DefaultChainID").Return(int64(cmd.SepoliaChainID)).Maybe()
+	return chains
+}
+
+// mockStorage returns the storage mock, which runs the units of work on itself
+func mockStorage(t *testing.T) *storagemocks.StorageProvider {
+	st := storagemocks.NewStorageProvider(t)
+	st.On("WithTx", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, fn func(tx store.StorageProvider) error) error {
+			return fn(st)
+		}).Maybe()
+	return st
+}
+
+// mockBlockRecord returns the record of the block transaction sent to the address, with logs emitted by the
+// other addresses
+func mockBlockRecord(txHash, to string, logAddresses ...string) *store.TxRecord {
+	record := &store.TxRecord{Transaction: &models.Transaction{
+		ChainID:     cmd.SepoliaChainID,
+		TXHash:      txHash,
+		FromAddress: "0x1fc35b79fb11ea7d4532da128dfa9db573c51b09",
+		ToAddress:   null.StringFrom(to),
+	}}
+	for _, address := range logAddresses {
+		record.Logs = append(record.Logs, &models.TransactionLog{TXHash: txHash, Address: address})
+	}
+	return record
+}
+
 func chanToChan(ch chan network.TxResult) <-chan network.TxResult {
 	return ch
 }
@@ -287,14 +931,16 @@ func (s *ServiceTestSuite) TestGetAllTransactions() {
 	txList := mockEthereumTransactions()
 
 	type args struct {
-		tx  []*models.Transaction
-		err error
+		page store.Page
+		tx   []*models.Transaction
+		err  error
 	}
 	tests := []struct {
 		name     string
 		args     args
 		mockData args
 		want     []*models.Transaction
+		wantNext *store.Cursor
 		wantErr  bool
 	}{
 		{
@@ -316,6 +962,27 @@ func (s *ServiceTestSuite) TestGetAllTransactions() {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "with limit, it returns the page along with the cursor of the next page",
+			args: args{page: store.Page{Limit: 1}},
+			mockData: args{
+				page: store.Page{Limit: 2},
+				tx:   txList,
+			},
+			want:     txList[:1],
+			wantNext: store.NewCursor(txList[0]),
+			wantErr:  false,
+		},
+		{
+			name: "with cursor, it returns the last page without the cursor of the next page",
+			args: args{page: store.Page{Limit: 2, Cursor: store.NewCursor(txList[0])}},
+			mockData: args{
+				page: store.Page{Limit: 3, Cursor: store.NewCursor(txList[0])},
+				tx:   txList,
+			},
+			want:    txList,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -323,16 +990,17 @@ func (s *ServiceTestSuite) TestGetAllTransactions() {
 			st := storagemocks.NewStorageProvider(s.T())
 			net := netmocks.NewEthereumProvider(s.T())
 
-			st.On("GetAllTransactions").
+			st.On("GetAllTransactions", mock.Anything, int64(cmd.SepoliaChainID), store.Filter{}, tt.mockData.page).
 				Return(tt.mockData.tx, tt.mockData.err)
-			appService := NewService(s.ctx, s.vp, st, net)
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
 
-			freshTxs, err := appService.GetAllTransactions()
+			freshTxs, next, err := appService.GetAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{}, tt.args.page)
 			if !tt.wantErr {
 				assert.Nil(t, err)
 
 				assert.NotNil(t, freshTxs)
 				assert.Equal(t, tt.want, freshTxs)
+				assert.Equal(t, tt.wantNext, next)
 			} else {
 				assert.Error(t, err)
 
@@ -385,11 +1053,12 @@ func (s *ServiceTestSuite) TestGetMyTransactions() {
 			st := storagemocks.NewStorageProvider(s.T())
 			net := netmocks.NewEthereumProvider(s.T())
 
-			st.On("GetMyTransactions", mock.AnythingOfType("int")).
-				Return(tt.mockData.tx, tt.mockData.err)
-			appService := NewService(s.ctx, s.vp, st, net)
+			st.On("GetMyTransactions", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("int"), store.Filter{},
+				store.Page{}).Return(tt.mockData.tx, tt.mockData.err)
+			appService := NewService(s.ctx, s.vp, st, mockChains(s.T(), net), nil)
 
-			freshTxs, err := appService.GetMyTransactions(user1.ID)
+			freshTxs, _, err := appService.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user1.ID, store.Filter{},
+				store.Page{})
 			if !tt.wantErr {
 				assert.Nil(t, err)
 
@@ -419,10 +1088,11 @@ func mockUser(userID int) *models.User {
 
 func mockEthereumTransactions() []*models.Transaction {
 	txList := []*models.Transaction{{
+		ChainID:   cmd.SepoliaChainID,
 		TXHash:    "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
-		TXStatus:  1,
-		BlockHash: "0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577",
-		BlockNumber: types.Decimal{
+		TXStatus:  null.IntFrom(1),
+		BlockHash: null.StringFrom("0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577"),
+		BlockNumber: types.NullDecimal{
 			Big: decimal.New(5703601, 0),
 		},
 		FromAddress:     "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
@@ -433,10 +1103,11 @@ func mockEthereumTransactions() []*models.Transaction {
 		Value:           "500000000000000000",
 	},
 		{
+			ChainID:   cmd.SepoliaChainID,
 			TXHash:    "0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
-			TXStatus:  1,
-			BlockHash: "0xc5a3664f031da2458646a01e18e6957fd1f43715524d94b7336a004b5635837d",
-			BlockNumber: types.Decimal{
+			TXStatus:  null.IntFrom(1),
+			BlockHash: null.StringFrom("0xc5a3664f031da2458646a01e18e6957fd1f43715524d94b7336a004b5635837d"),
+			BlockNumber: types.NullDecimal{
 				Big: decimal.New(5702816, 0),
 			},
 			FromAddress:     "0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70",
@@ -0,0 +1,40 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidABI describes an error when the uploaded contract ABI cannot be parsed
+var ErrInvalidABI = errors.New("invalid contract abi")
+
+// InputDecoder defines the abstraction around the registry of contract ABIs, used to decode the transaction input.
+//
+//go:generate mockery --name InputDecoder
+type InputDecoder interface {
+	DecodeInput(ctx context.Context, chainID int64, to, input string) *DecodedInput
+	RegisterABI(ctx context.Context, chainID int64, address, abiJSON string) ([]string, error)
+}
+
+const (
+	// SourceABI marks the input decoded with the uploaded contract ABI
+	SourceABI = "abi"
+	// SourceSelector marks the input decoded with the built-in 4-byte selector table, without argument names
+	SourceSelector = "selector"
+)
+
+// DecodedInput is the called method along with its arguments
+type DecodedInput struct {
+	Method    string
+	Signature string
+	Source    string
+	Arguments []Argument
+}
+
+// Argument is the decoded method argument, its value is normalized to json friendly types
+// (e.g. the integers and addresses are strings)
+type Argument struct {
+	Name  string
+	Type  string
+	Value any
+}
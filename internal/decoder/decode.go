@@ -0,0 +1,89 @@
+package decoder
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// decode unpacks the arguments of the method out of the call data
+func decode(method *abi.Method, data []byte, source string) (*DecodedInput, error) {
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := make([]Argument, 0, len(values))
+	for i, value := range values {
+		arguments = append(arguments, Argument{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: normalize(value),
+		})
+	}
+
+	return &DecodedInput{
+		Method:    method.RawName,
+		Signature: method.Sig,
+		Source:    source,
+		Arguments: arguments,
+	}, nil
+}
+
+// normalize converts the unpacked value into json friendly types: the integers (which might exceed the
+// javascript precision) are decimal strings, the addresses and the bytes are hex strings, the tuples are
+// objects by their field names
+func normalize(value any) any {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case string, bool:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// fixed size bytes, e.g. bytes32
+			data := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(data), rv)
+			return hexutil.Encode(data)
+		}
+		return normalizeList(rv)
+	case reflect.Slice:
+		return normalizeList(rv)
+	case reflect.Struct:
+		fields := make(map[string]any, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Tag.Get("json")
+			if name == "" {
+				name = rv.Type().Field(i).Name
+			}
+			fields[name] = normalize(rv.Field(i).Interface())
+		}
+		return fields
+	default:
+		return value
+	}
+}
+
+func normalizeList(rv reflect.Value) []any {
+	list := make([]any, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		list = append(list, normalize(rv.Index(i).Interface()))
+	}
+	return list
+}
@@ -0,0 +1,196 @@
+package decoder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+const (
+	// maxCachedMisses limits the count of the contracts remembered as having no uploaded ABI
+	maxCachedMisses = 4096
+	// cachedMissTTL limits how long the contract is remembered as having no uploaded ABI, so the ABI uploaded
+	// through another instance of the server is picked up afterwards
+	cachedMissTTL = 10 * time.Minute
+)
+
+// contractKey identifies the contract by its chain and lower case address
+type contractKey struct {
+	chainID int64
+	address string
+}
+
+// Registry decodes the transaction input with the uploaded contract ABIs, or with the built-in 4-byte
+// selector table for the contracts without one
+type Registry struct {
+	st        store.StorageProvider
+	selectors map[string]*abi.Method
+
+	mu sync.RWMutex
+	// parsed ABIs of the contracts looked up so far
+	contracts map[contractKey]*abi.ABI
+	// the contracts without uploaded ABI by the time of their lookup, the oldest ones are evicted beyond maxMisses
+	misses    map[contractKey]time.Time
+	missOrder []contractMiss
+	maxMisses int
+	missTTL   time.Duration
+}
+
+type contractMiss struct {
+	key contractKey
+	at  time.Time
+}
+
+// NewRegistry creates the registry with the selector table of the configured file, without it
+// only the uploaded ABIs are used
+func NewRegistry(vp *viper.Viper, st store.StorageProvider) *Registry {
+	selectors, err := LoadSelectors(vp.GetString(cmd.ABISelectorsFile))
+	if err != nil {
+		log.Warnf("the 4-byte selector table is not available: %v", err)
+		selectors = make(map[string]*abi.Method)
+	}
+
+	return &Registry{
+		st:        st,
+		selectors: selectors,
+		contracts: make(map[contractKey]*abi.ABI),
+		misses:    make(map[contractKey]time.Time),
+		maxMisses: maxCachedMisses,
+		missTTL:   cachedMissTTL,
+	}
+}
+
+// DecodeInput decodes the input of the transaction sent to the contract, it returns nil when neither
+// the contract ABI nor the selector table knows the called method
+func (r *Registry) DecodeInput(ctx context.Context, chainID int64, to, input string) *DecodedInput {
+	data, err := hexutil.Decode(input)
+	if err != nil || len(data) < 4 {
+		return nil
+	}
+
+	if contractABI := r.contractABI(ctx, chainID, to); contractABI != nil {
+		if method, err := contractABI.MethodById(data[:4]); err == nil {
+			if decoded, err := decode(method, data, SourceABI); err == nil {
+				return decoded
+			}
+		}
+	}
+
+	if method, found := r.selectors[hexutil.Encode(data[:4])]; found {
+		if decoded, err := decode(method, data, SourceSelector); err == nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+// RegisterABI stores the ABI of the contract, replacing the previous one, and returns the signatures
+// of its methods
+func (r *Registry) RegisterABI(ctx context.Context, chainID int64, address, abiJSON string) ([]string, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidABI, err)
+	}
+	if len(contractABI.Methods) == 0 {
+		return nil, fmt.Errorf("%w: no methods", ErrInvalidABI)
+	}
+
+	key := contractKey{chainID: chainID, address: strings.ToLower(address)}
+	err = r.st.UpsertContract(ctx, &models.Contract{ChainID: key.chainID, Address: key.address, Abi: abiJSON})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.contracts[key] = &contractABI
+	delete(r.misses, key)
+	r.mu.Unlock()
+
+	signatures := make([]string, 0, len(contractABI.Methods))
+	for _, method := range contractABI.Methods {
+		signatures = append(signatures, method.Sig)
+	}
+	slices.Sort(signatures)
+
+	return signatures, nil
+}
+
+// contractABI returns the parsed ABI of the contract, loaded from the database on the first lookup; the contract
+// without uploaded ABI is looked up again once it is evicted from the misses or remembered for longer than missTTL
+func (r *Registry) contractABI(ctx context.Context, chainID int64, address string) *abi.ABI {
+	if address == "" {
+		return nil
+	}
+	key := contractKey{chainID: chainID, address: strings.ToLower(address)}
+
+	r.mu.RLock()
+	contractABI, found := r.contracts[key]
+	missedAt, missed := r.misses[key]
+	r.mu.RUnlock()
+	if found {
+		return contractABI
+	}
+	if missed && time.Since(missedAt) < r.missTTL {
+		return nil
+	}
+
+	contract, err := r.st.GetContract(ctx, key.chainID, key.address)
+	if err != nil {
+		// don't remember the failure, the database might be back on the next lookup
+		log.Errorf("cannot load the abi of contract '%s': %v", address, err)
+		return nil
+	}
+
+	if contract != nil {
+		parsed, err := abi.JSON(strings.NewReader(contract.Abi))
+		if err != nil {
+			log.Errorf("cannot parse the stored abi of contract '%s': %v", address, err)
+		} else {
+			contractABI = &parsed
+		}
+	}
+
+	r.mu.Lock()
+	if contractABI != nil {
+		r.contracts[key] = contractABI
+	} else {
+		r.addMiss(key)
+	}
+	r.mu.Unlock()
+
+	return contractABI
+}
+
+// addMiss remembers the contract without uploaded ABI, evicting the oldest ones beyond maxMisses; caller must hold
+// the lock
+func (r *Registry) addMiss(key contractKey) {
+	now := time.Now()
+	r.misses[key] = now
+	r.missOrder = append(r.missOrder, contractMiss{key: key, at: now})
+	for len(r.missOrder) > r.maxMisses {
+		oldest := r.missOrder[0]
+		r.missOrder = r.missOrder[1:]
+		// the contract looked up again or registered since then is not remembered by its oldest miss anymore
+		if at, found := r.misses[oldest.key]; found && at.Equal(oldest.at) {
+			delete(r.misses, oldest.key)
+		}
+	}
+}
+
+// compile-time check to ensure Registry implements the interface
+var (
+	_ InputDecoder = &Registry{}
+)
@@ -0,0 +1,199 @@
+package decoder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	storagemocks "ethereum-fetcher/internal/store/mocks"
+)
+
+const (
+	tokenAddress = "0x4c16D8C078eF6B56700C1BE19a336915962df072"
+	tokenABI     = `[{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]}]`
+	// transfer(0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70, 1000)
+	transferInput = "0xa9059cbb000000000000000000000000d5e6f34bbd4251195c03e7bf3660677ed2315f70" +
+		"00000000000000000000000000000000000000000000000000000000000003e8"
+)
+
+type RegistryTestSuite struct {
+	suite.Suite
+	st       *storagemocks.StorageProvider
+	registry *Registry
+}
+
+// this function executes before each test case
+func (s *RegistryTestSuite) SetupTest() {
+	cmd.LogInit("fatal")
+
+	vp := cmd.NewViper()
+	vp.Set(cmd.ABISelectorsFile, "selectors.json")
+
+	s.st = storagemocks.NewStorageProvider(s.T())
+	s.registry = NewRegistry(vp, s.st)
+}
+
+func (s *RegistryTestSuite) TestDecodeInput() {
+	t := s.T()
+
+	tests := []struct {
+		name       string
+		to         string
+		input      string
+		contract   *models.Contract
+		wantSource string
+		wantArgs   []Argument
+	}{
+		{
+			name:       "with uploaded contract abi, it returns the named arguments",
+			to:         tokenAddress,
+			input:      transferInput,
+			contract:   &models.Contract{ChainID: cmd.SepoliaChainID, Abi: tokenABI},
+			wantSource: SourceABI,
+			wantArgs: []Argument{
+				{Name: "recipient", Type: "address", Value: "0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70"},
+				{Name: "amount", Type: "uint256", Value: "1000"},
+			},
+		},
+		{
+			name:       "without contract abi, it falls back to the selector table",
+			to:         tokenAddress,
+			input:      transferInput,
+			wantSource: SourceSelector,
+			wantArgs: []Argument{
+				{Name: "arg0", Type: "address", Value: "0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70"},
+				{Name: "arg1", Type: "uint256", Value: "1000"},
+			},
+		},
+		{
+			name:  "with unknown selector, it returns nothing",
+			to:    tokenAddress,
+			input: "0xdeadbeef",
+		},
+		{
+			name:  "with plain transfer of ether, it returns nothing",
+			to:    tokenAddress,
+			input: "0x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+			s.st.On("GetContract", mock.Anything, int64(cmd.SepoliaChainID), "0x4c16d8c078ef6b56700c1be19a336915962df072").
+				Return(tt.contract, nil).Maybe()
+
+			decoded := s.registry.DecodeInput(context.Background(), cmd.SepoliaChainID, tt.to, tt.input)
+			if tt.wantSource == "" {
+				s.Nil(decoded)
+				return
+			}
+
+			s.Require().NotNil(decoded)
+			s.Equal("transfer", decoded.Method)
+			s.Equal("transfer(address,uint256)", decoded.Signature)
+			s.Equal(tt.wantSource, decoded.Source)
+			s.Equal(tt.wantArgs, decoded.Arguments)
+		})
+	}
+}
+
+func (s *RegistryTestSuite) TestRegisterABI() {
+	r := s.Require()
+
+	s.st.On("UpsertContract", mock.Anything, mock.MatchedBy(func(contract *models.Contract) bool {
+		return contract.Address == "0x4c16d8c078ef6b56700c1be19a336915962df072"
+	})).Return(nil).Once()
+
+	methods, err := s.registry.RegisterABI(context.Background(), cmd.SepoliaChainID, tokenAddress, tokenABI)
+	r.NoError(err)
+	r.Equal([]string{"transfer(address,uint256)"}, methods)
+
+	// the registered abi is used right away, without loading it from the database
+	decoded := s.registry.DecodeInput(context.Background(), cmd.SepoliaChainID, tokenAddress, transferInput)
+	r.NotNil(decoded)
+	r.Equal(SourceABI, decoded.Source)
+
+	_, err = s.registry.RegisterABI(context.Background(), cmd.SepoliaChainID, tokenAddress, `{"not": "an abi"}`)
+	r.True(errors.Is(err, ErrInvalidABI), "broken abi must be rejected")
+}
+
+func (s *RegistryTestSuite) TestDecodeInputWithoutABI() {
+	r := s.Require()
+
+	otherAddress := "0xd5e6f34bbd4251195c03e7bf3660677ed2315f70"
+	lookups := func(address string, times int) {
+		s.st.On("GetContract", mock.Anything, int64(cmd.SepoliaChainID), address).Return(nil, nil).Times(times)
+	}
+	decode := func(address string) {
+		decoded := s.registry.DecodeInput(context.Background(), cmd.SepoliaChainID, address, transferInput)
+		r.NotNil(decoded)
+		r.Equal(SourceSelector, decoded.Source)
+	}
+
+	// the contract without abi is looked up once, then it is remembered
+	lookups("0x4c16d8c078ef6b56700c1be19a336915962df072", 1)
+	decode(tokenAddress)
+	decode(tokenAddress)
+
+	// until it is evicted by the other contracts without abi
+	s.registry.maxMisses = 1
+	lookups(otherAddress, 1)
+	decode(otherAddress)
+	r.Len(s.registry.misses, 1, "the misses must be bounded")
+	lookups("0x4c16d8c078ef6b56700c1be19a336915962df072", 1)
+	decode(tokenAddress)
+
+	// or it expires
+	s.registry.missTTL = 0
+	lookups("0x4c16d8c078ef6b56700c1be19a336915962df072", 1)
+	decode(tokenAddress)
+	s.st.AssertExpectations(s.T())
+}
+
+func (s *RegistryTestSuite) TestParseSignatureWithTuple() {
+	r := s.Require()
+
+	method, err := ParseSignature("exactInputSingle((address,address,uint24,address,uint256,uint256,uint256,uint160))")
+	r.NoError(err)
+	r.Equal("0x414bf389", hexutil.Encode(method.ID))
+
+	recipient := common.HexToAddress("0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70")
+	params := struct {
+		Field0 common.Address
+		Field1 common.Address
+		Field2 *big.Int
+		Field3 common.Address
+		Field4 *big.Int
+		Field5 *big.Int
+		Field6 *big.Int
+		Field7 *big.Int
+	}{recipient, recipient, big.NewInt(3000), recipient, big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(0)}
+	packed, err := method.Inputs.Pack(params)
+	r.NoError(err)
+
+	decoded, err := decode(method, append(method.ID, packed...), SourceSelector)
+	r.NoError(err)
+	r.Len(decoded.Arguments, 1)
+	fields, ok := decoded.Arguments[0].Value.(map[string]any)
+	r.True(ok, "tuple must be decoded as object")
+	r.Equal("3000", fields["field2"])
+	r.Equal(recipient.Hex(), fields["field3"])
+
+	_, err = ParseSignature("broken(uint256")
+	r.Error(err)
+}
+
+func TestRegistryTestSuite(t *testing.T) {
+	suite.Run(t, new(RegistryTestSuite))
+}
@@ -0,0 +1,136 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	log "github.com/sirupsen/logrus"
+)
+
+// LoadSelectors reads the 4-byte selector table, a json object of hex selectors and their text signatures,
+// e.g. {"0xa9059cbb": "transfer(address,uint256)"}; the entries that don't match their selector are skipped
+func LoadSelectors(path string) (map[string]*abi.Method, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read selectors file: %v", err)
+	}
+
+	var table map[string]string
+	if err = json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("cannot parse selectors file: %v", err)
+	}
+
+	selectors := make(map[string]*abi.Method, len(table))
+	for selector, signature := range table {
+		method, err := ParseSignature(signature)
+		if err != nil {
+			log.Warnf("skipping selector %s: %v", selector, err)
+			continue
+		}
+		if id := hexutil.Encode(method.ID); id != strings.ToLower(selector) {
+			log.Warnf("skipping selector %s: signature '%s' has selector %s", selector, signature, id)
+			continue
+		}
+		selectors[hexutil.Encode(method.ID)] = method
+	}
+
+	return selectors, nil
+}
+
+// ParseSignature builds the method out of its text signature, e.g. "transfer(address,uint256)"; since the
+// signature has no argument names, they are named by their position (arg0, arg1, ...)
+func ParseSignature(signature string) (*abi.Method, error) {
+	signature = strings.ReplaceAll(signature, " ", "")
+
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("malformed signature '%s'", signature)
+	}
+	name := signature[:open]
+
+	types, err := splitTypes(signature[open+1 : len(signature)-1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed signature '%s': %v", signature, err)
+	}
+
+	inputs := make(abi.Arguments, 0, len(types))
+	for i, typ := range types {
+		marshaling, err := parseType(typ, fmt.Sprintf("arg%d", i))
+		if err != nil {
+			return nil, fmt.Errorf("malformed signature '%s': %v", signature, err)
+		}
+
+		abiType, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return nil, fmt.Errorf("malformed signature '%s': %v", signature, err)
+		}
+		inputs = append(inputs, abi.Argument{Name: marshaling.Name, Type: abiType})
+	}
+
+	method := abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil)
+	return &method, nil
+}
+
+// parseType converts the signature type into its abi description, the tuples, e.g. "(uint256,address)[]",
+// are described by their components
+func parseType(typ, name string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(typ, "(") {
+		return abi.ArgumentMarshaling{Name: name, Type: typ}, nil
+	}
+
+	closing := strings.LastIndex(typ, ")")
+	if closing < 0 {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("unbalanced tuple '%s'", typ)
+	}
+
+	types, err := splitTypes(typ[1:closing])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+
+	components := make([]abi.ArgumentMarshaling, 0, len(types))
+	for i, componentType := range types {
+		component, err := parseType(componentType, fmt.Sprintf("field%d", i))
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		components = append(components, component)
+	}
+
+	return abi.ArgumentMarshaling{Name: name, Type: "tuple" + typ[closing+1:], Components: components}, nil
+}
+
+// splitTypes splits the comma separated list of types, but not the components of the tuples
+func splitTypes(list string) ([]string, error) {
+	if list == "" {
+		return nil, nil
+	}
+
+	var types []string
+	depth, start := 0, 0
+	for i, c := range list {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				types = append(types, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+
+	return append(types, list[start:]), nil
+}
@@ -2,13 +2,17 @@ package di
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"ethereum-fetcher/cmd"
 	"ethereum-fetcher/internal/app"
+	"ethereum-fetcher/internal/decoder"
 	"ethereum-fetcher/internal/network"
 	"ethereum-fetcher/internal/server"
 	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg"
+	"ethereum-fetcher/internal/store/sqlite"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/viper"
@@ -37,7 +41,12 @@ func SetupContainer(container *dig.Container) error {
 		return err
 	}
 
-	err = container.Provide(NewEthNode)
+	err = container.Provide(NewChains)
+	if err != nil {
+		return err
+	}
+
+	err = container.Provide(NewDecoder)
 	if err != nil {
 		return err
 	}
@@ -47,6 +56,11 @@ func SetupContainer(container *dig.Container) error {
 		return err
 	}
 
+	err = container.Provide(NewBackfiller)
+	if err != nil {
+		return err
+	}
+
 	err = container.Provide(NewEndpoint)
 	if err != nil {
 		return err
@@ -72,17 +86,49 @@ func NewContextWithCancel() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithCancel(context.Background())
 }
 
+// NewStore provides the storage selected by STORAGE_DRIVER, the embedded sqlite one runs without postgres
 func NewStore(ctx context.Context, vp *viper.Viper) (store.StorageProvider, error) {
-	return pg.NewStore(ctx, vp)
+	switch driver := vp.GetString(cmd.StorageDriver); driver {
+	case cmd.StorageDriverPostgres:
+		st, err := pg.NewStore(ctx, vp)
+		if err != nil {
+			return nil, err
+		}
+		if st == nil {
+			// the nil store, left by the shutdown request, must not end up as non-nil storage provider
+			return nil, errors.New("postgres storage setup got interrupted by shutdown")
+		}
+		return st, nil
+	case cmd.StorageDriverSQLite:
+		return sqlite.NewStore(ctx, vp)
+	default:
+		return nil, fmt.Errorf("unknown storage driver '%s'", driver)
+	}
+}
+
+func NewChains(ctx context.Context, vp *viper.Viper) network.ChainsProvider {
+	return network.NewChains(ctx, vp)
 }
 
-func NewEthNode(ctx context.Context, vp *viper.Viper) network.EthereumProvider {
-	return network.NewEthNode(ctx, vp)
+func NewDecoder(vp *viper.Viper, st store.StorageProvider) decoder.InputDecoder {
+	return decoder.NewRegistry(vp, st)
+}
+
+func NewAppService(ctx context.Context, vp *viper.Viper, st store.StorageProvider, chains network.ChainsProvider,
+	dec decoder.InputDecoder) app.ServiceProvider {
+	service := app.NewService(ctx, vp, st, chains, dec)
+	go service.RefreshPendingTransactions(vp.GetDuration(cmd.PendingRefresh))
+	for _, chainID := range chains.ChainIDs() {
+		go service.WatchReorgs(chainID, vp.GetDuration(cmd.ReorgPollInterval))
+		go service.IngestBlocks(chainID, vp.GetDuration(cmd.WatchPollInterval))
+	}
+	return service
 }
 
-func NewAppService(ctx context.Context, vp *viper.Viper, st store.StorageProvider, net network.EthereumProvider,
-) app.ServiceProvider {
-	return app.NewService(ctx, vp, st, net)
+// NewBackfiller provides the service without its background loops, those are not needed by the backfill run
+func NewBackfiller(ctx context.Context, vp *viper.Viper, st store.StorageProvider,
+	chains network.ChainsProvider) app.Backfiller {
+	return app.NewService(ctx, vp, st, chains, nil)
 }
 
 func NewEndpoint(ctx context.Context, vp *viper.Viper, ap app.ServiceProvider) server.EndPointProvider {
@@ -3,11 +3,17 @@ package network
 import (
 	"context"
 
-	"ethereum-fetcher/internal/store/pg/models"
+	"ethereum-fetcher/internal/store"
 )
 
 //go:generate mockery --name EthereumProvider
 type EthereumProvider interface {
-	GetTransactionByHash(task TxTask) (*models.Transaction, error)
+	GetTransactionByHash(task TxTask) (*store.TxRecord, error)
 	ScheduleTask(muxCtx context.Context, txHash string) (<-chan TxResult, error)
+	HeadBlockNumber(ctx context.Context) (uint64, error)
+	FinalizedBlockNumber(ctx context.Context) (uint64, error)
+	BlockHashByNumber(ctx context.Context, number uint64) (string, error)
+	BlockTransactions(ctx context.Context, number uint64) ([]*store.TxRecord, error)
+	SubscribeNewHeads(ctx context.Context, heads chan<- uint64) (Subscription, error)
+	SendRawTransaction(ctx context.Context, rawTx []byte) (*store.TxRecord, error)
 }
@@ -0,0 +1,208 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// credit models of the node providers, describing how a batch is charged against the rate limit
+const (
+	// CreditPerCall charges every call inside the batch with the cost of its method, as Infura and Alchemy do
+	CreditPerCall = "per-call"
+	// CreditPerRequest charges the whole batch as a single request
+	CreditPerRequest = "per-request"
+)
+
+// collectBatch gathers the pending tasks, starting with the provided one, until the batch is full
+// or the linger window expires
+func (n *EthNode) collectBatch(first TxTask) []TxTask {
+	batch := []TxTask{first}
+	if n.batchSize <= 1 {
+		return batch
+	}
+
+	linger := time.NewTimer(n.batchLinger)
+	defer linger.Stop()
+
+	for len(batch) < n.batchSize {
+		select {
+		case task := <-n.tasksChan:
+			batch = append(batch, task)
+		case <-linger.C:
+			return batch
+		case <-n.ctx.Done():
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// processBatch fetches the batched tasks and delivers the result to each one of them
+func (n *EthNode) processBatch(batch []TxTask) {
+	pending := make([]TxTask, 0, len(batch))
+	for _, task := range batch {
+		// provided context must be a multiplexed version of app context and http request context
+		if task.Ctx.Err() != nil {
+			n.complete(task, TxResult{Err: fmt.Errorf("task canceled")})
+			continue
+		}
+		pending = append(pending, task)
+	}
+
+	switch len(pending) {
+	case 0:
+		return
+	case 1:
+		log.Infof("start processing task: %s at time %v", pending[0].TxHash, time.Now())
+		tx, err := n.GetTransactionByHash(pending[0])
+		log.Infof("completed task: %s at time %v", pending[0].TxHash, time.Now())
+		n.complete(pending[0], TxResult{Tx: tx, Err: err})
+	default:
+		log.Infof("start processing batch of %d tasks at time %v", len(pending), time.Now())
+		results := n.fetchBatch(pending)
+		log.Infof("completed batch of %d tasks at time %v", len(pending), time.Now())
+		for i, task := range pending {
+			n.complete(task, results[i])
+		}
+	}
+}
+
+// complete delivers the result to the task, unless it got canceled meanwhile, and closes its channel
+func (n *EthNode) complete(task TxTask, res TxResult) {
+	defer close(task.ResChan)
+
+	if res.Tx == nil {
+		res.Tx = &store.TxRecord{Transaction: &models.Transaction{TXHash: task.TxHash}}
+	}
+
+	select {
+	case <-task.Ctx.Done():
+		select {
+		case task.ResChan <- TxResult{Tx: res.Tx, Err: fmt.Errorf("task canceled")}:
+		default:
+		}
+	case task.ResChan <- res:
+	}
+}
+
+// fetchBatch fetches the details of all the tasks with a single json-rpc batch call, and then their receipts
+// with another one
+func (n *EthNode) fetchBatch(tasks []TxTask) []TxResult {
+	results := make([]TxResult, len(tasks))
+
+	ctx, cancel := mergeTaskContexts(n.ctx, tasks)
+	defer cancel()
+
+	txs := make([]json.RawMessage, len(tasks))
+	elems := make([]rpc.BatchElem, 0, len(tasks))
+	for i, task := range tasks {
+		elems = append(elems, rpc.BatchElem{Method: methodTransactionByHash,
+			Args: []any{common.HexToHash(task.TxHash)}, Result: &txs[i]})
+	}
+
+	if err := n.batchCall(ctx, elems); err != nil {
+		for i := range results {
+			results[i].Err = fmt.Errorf("failed to fetch transactions batch: %w", err)
+		}
+		return results
+	}
+
+	// the details tell the block of each mined transaction, so the transactions of the same block share its receipts
+	ethTXs := make([]*types.Transaction, len(tasks))
+	blockHashes := make([]*common.Hash, len(tasks))
+	for i := range tasks {
+		ethTX := new(types.Transaction)
+		var txBlock rpcTransactionBlock
+		err := decodeBatchResult(elems[i], txs[i], ethTX)
+		if err == nil {
+			err = json.Unmarshal(txs[i], &txBlock)
+		}
+
+		switch {
+		case err != nil:
+			results[i].Err = fmt.Errorf("failed to fetch transaction details: %w", classified(err))
+			// failures of single calls inside the batch (e.g. 429) are retried outside of it
+			if errors.Is(results[i].Err, ErrTransient) {
+				results[i].Tx, results[i].Err = n.GetTransactionByHash(tasks[i])
+			}
+		case txBlock.BlockHash == nil:
+			// the transaction is known, but still waits in the mempool to be mined
+			results[i].Tx, results[i].Err = n.newTransaction(ethTX, nil)
+		default:
+			ethTXs[i], blockHashes[i] = ethTX, txBlock.BlockHash
+		}
+	}
+
+	n.fetchReceipts(ctx, tasks, ethTXs, blockHashes, results)
+
+	return results
+}
+
+// batchCall sends the calls with a single json-rpc batch, obeying the rate limitations and retrying the transient
+// failures of the whole batch
+func (n *EthNode) batchCall(ctx context.Context, elems []rpc.BatchElem) error {
+	return n.retry.Do(ctx, func() error {
+		if err := n.rateLimiter.Wait(ctx, n.batchCost(elems)); err != nil {
+			return err
+		}
+		return n.pool.Do(ctx, func(client *ethclient.Client) error {
+			return client.Client().BatchCallContext(ctx, elems)
+		})
+	})
+}
+
+// batchCost returns the credits charged for the batch, according to the credit model of the provider
+func (n *EthNode) batchCost(elems []rpc.BatchElem) int {
+	if n.creditModel == CreditPerRequest {
+		return 1
+	}
+
+	cost := 0
+	for _, elem := range elems {
+		cost += n.rateLimiter.Cost(elem.Method)
+	}
+	return cost
+}
+
+// decodeBatchResult reports the error of the batch element or decodes its raw result into v
+func decodeBatchResult(elem rpc.BatchElem, raw json.RawMessage, v any) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return ethereum.NotFound
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// mergeTaskContexts returns a context, derived from the parent, that is canceled once all the tasks are canceled
+func mergeTaskContexts(parent context.Context, tasks []TxTask) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	go func() {
+		for _, task := range tasks {
+			select {
+			case <-task.Ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,779 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/suite"
+)
+
+// BatchTestSuite proves that the pending tasks are fetched with a single json-rpc batch call
+type BatchTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+	rpc    *fakeRPC
+	node   *EthNode
+}
+
+// this function executes before the test suite begins execution
+func (s *BatchTestSuite) SetupSuite() {
+	cmd.LogInit("fatal")
+}
+
+// this function executes before each test case
+func (s *BatchTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.rpc = newFakeRPC(s.T())
+
+	pool, err := NewNodePool(s.ctx, []string{s.rpc.URL}, 1, 0, nil)
+	s.Require().NoError(err)
+
+	s.node = &EthNode{
+		ctx:         s.ctx,
+		pool:        pool,
+		rateLimiter: NewRateLimiter(100, 100, map[string]int{methodTransactionReceipt: 2}),
+		workersChan: make(chan struct{}, maxFetchWorkers),
+		tasksChan:   make(chan TxTask),
+		batchSize:   10,
+		batchLinger: 100 * time.Millisecond,
+		creditModel: CreditPerCall,
+		flights:     make(map[string]*flight),
+		blocks:      newBlockCache[*models.Block](maxCachedBlocks),
+		proofs:      newBlockCache[*blockProof](maxCachedBlocks),
+	}
+	s.node.blockReceipts.Store(true)
+	go s.node.dispatch()
+}
+
+// this function executes after each test case
+func (s *BatchTestSuite) TearDownTest() {
+	s.cancel()
+	s.rpc.Close()
+}
+
+func (s *BatchTestSuite) TestSingleRoundTrip() {
+	r := s.Require()
+
+	hashes := []string{s.rpc.addTx(1), s.rpc.addTx(2), s.rpc.addTx(3)}
+	missing := "0x44443f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df74444"
+
+	var resChans []<-chan TxResult
+	for _, hash := range append(hashes, missing) {
+		resChan, err := s.node.ScheduleTask(s.ctx, hash)
+		r.NoError(err)
+		resChans = append(resChans, resChan)
+	}
+
+	for i, hash := range hashes {
+		res := <-resChans[i]
+		r.NoError(res.Err)
+		r.Equal(hash, res.Tx.TXHash)
+		r.Equal(s.rpc.sender.Hex(), res.Tx.FromAddress)
+	}
+	r.Error((<-resChans[len(hashes)]).Err, "missing transaction must fail on its own")
+
+	r.Equal(2, s.rpc.posts(), "all transactions must be fetched with a single request, and their block with another")
+	r.Zero(s.rpc.served("eth_getTransactionReceipt"), "the receipts of the same block must be fetched at once")
+	r.Equal(1, s.rpc.served("eth_getBlockReceipts"))
+	// each call of the batches must be charged with the cost of its method - the details of each transaction,
+	// and the body and the receipts of their block
+	r.InDelta(100-4-1-1, s.tokens(), 1)
+}
+
+func (s *BatchTestSuite) TestCreditPerRequest() {
+	r := s.Require()
+	s.node.creditModel = CreditPerRequest
+
+	var resChans []<-chan TxResult
+	for i := 1; i <= 3; i++ {
+		resChan, err := s.node.ScheduleTask(s.ctx, s.rpc.addTx(uint64(i)))
+		r.NoError(err)
+		resChans = append(resChans, resChan)
+	}
+	for _, resChan := range resChans {
+		r.NoError((<-resChan).Err)
+	}
+
+	r.InDelta(98, s.tokens(), 1, "each of the two batches must be charged once")
+}
+
+func (s *BatchTestSuite) TestPendingTransactions() {
+	r := s.Require()
+
+	mined := s.rpc.addTx(1)
+	pending := s.rpc.addPendingTx(2)
+
+	for _, batchSize := range []int{1, 10} {
+		s.node.batchSize = batchSize
+
+		minedChan, err := s.node.ScheduleTask(s.ctx, mined)
+		r.NoError(err)
+		pendingChan, err := s.node.ScheduleTask(s.ctx, pending)
+		r.NoError(err)
+
+		res := <-minedChan
+		r.NoError(res.Err)
+		r.False(res.Tx.Pending)
+		r.True(res.Tx.BlockNumber.Big != nil)
+		r.Len(res.Tx.Logs, 1, "the receipt logs must be delivered along with the transaction")
+		r.Equal(7, res.Tx.Logs[0].LogIndex)
+		r.Equal("0x01", res.Tx.Logs[0].Data)
+		r.Equal(int64(21000), res.Tx.GasUsed.Int64)
+		r.Equal("1000", res.Tx.EffectiveGasPrice.String)
+		r.Equal(int(types.DynamicFeeTxType), res.Tx.TXType.Int)
+		r.Equal("1000", res.Tx.MaxFeePerGas.String)
+		r.Equal("1", res.Tx.MaxPriorityFeePerGas.String)
+		r.NotNil(res.Tx.Block, "the block must be delivered along with the mined transaction")
+		r.Equal(res.Tx.BlockHash.String, res.Tx.Block.BlockHash)
+		r.Equal(int64(fakeBlockTime), res.Tx.Block.Timestamp.Unix())
+		r.Equal("7", res.Tx.Block.BaseFeePerGas.String)
+
+		res = <-pendingChan
+		r.NoError(res.Err, "transaction without receipt must not fail")
+		r.True(res.Tx.Pending)
+		r.Equal(pending, res.Tx.TXHash)
+		r.False(res.Tx.TXStatus.Valid)
+		r.False(res.Tx.BlockHash.Valid)
+		r.Nil(res.Tx.BlockNumber.Big)
+		r.Empty(res.Tx.Logs)
+		r.Nil(res.Tx.Block)
+		r.Equal(int64(2), res.Tx.Nonce.Int64, "the transaction fields are known while pending")
+		r.Equal(int64(21000), res.Tx.GasLimit.Int64)
+		r.False(res.Tx.GasUsed.Valid, "the receipt fields are not known while pending")
+	}
+}
+
+func (s *BatchTestSuite) TestTraces() {
+	tests := []struct {
+		name        string
+		supported   bool
+		wantTraces  int
+		wantTracing bool
+	}{
+		{
+			name:        "with the debug namespace, the call frames are delivered along with the transaction",
+			supported:   true,
+			wantTraces:  3,
+			wantTracing: true,
+		},
+		{
+			name:        "without the debug namespace, the tracing is switched off and the transaction is delivered",
+			supported:   false,
+			wantTracing: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			r := s.Require()
+			s.rpc.traceSupported = tt.supported
+			s.node.tracing.Store(true)
+
+			for _, batchSize := range []int{1, 10} {
+				s.node.batchSize = batchSize
+
+				resChan, err := s.node.ScheduleTask(s.ctx, s.rpc.addTx(uint64(batchSize)))
+				r.NoError(err)
+				res := <-resChan
+				r.NoError(res.Err)
+				r.Len(res.Tx.Traces, tt.wantTraces)
+				r.Equal(tt.wantTracing, s.node.tracing.Load())
+				if tt.wantTraces == 0 {
+					r.Nil(res.Tx.Traces, "the untraced transaction must keep its stored traces")
+					continue
+				}
+
+				r.Equal("CALL", res.Tx.Traces[0].CallType)
+				r.Equal(0, res.Tx.Traces[0].Depth)
+				r.Equal("500", res.Tx.Traces[0].Value)
+				r.Equal(int64(21000), res.Tx.Traces[0].Gas)
+
+				// the nested calls follow their parent, in the order they were called
+				r.Equal(1, res.Tx.Traces[1].TraceIndex)
+				r.Equal(1, res.Tx.Traces[1].Depth)
+				r.Equal("1000000000000000000", res.Tx.Traces[1].Value, "the internal value transfer must be traced")
+				r.Equal(common.HexToAddress("0xd5e6f34bbd4251195c03e7bf3660677ed2315f70").Hex(),
+					res.Tx.Traces[1].ToAddress.String)
+				r.Equal("STATICCALL", res.Tx.Traces[2].CallType)
+				r.Equal(2, res.Tx.Traces[2].Depth)
+				r.Equal("0", res.Tx.Traces[2].Value, "the call without value moves nothing")
+				r.Equal("execution reverted", res.Tx.Traces[2].Error.String)
+			}
+		})
+	}
+}
+
+func (s *BatchTestSuite) TestVerifyReceipts() {
+	tests := []struct {
+		name         string
+		verifying    bool
+		forge        string
+		wantErr      error
+		wantVerified bool
+	}{
+		{
+			name:         "with verification on and the data matching the header, the transaction is verified",
+			verifying:    true,
+			wantVerified: true,
+		},
+		{
+			name:      "with verification off, the transaction is delivered unverified",
+			verifying: false,
+		},
+		{
+			name:      "with receipt not matching the receipts of the block, it fails",
+			verifying: true,
+			forge:     "receipt",
+			wantErr:   ErrVerificationFailed,
+		},
+		{
+			name:      "with block receipts not matching the receipts root, it fails",
+			verifying: true,
+			forge:     "receipts",
+			wantErr:   ErrVerificationFailed,
+		},
+		{
+			name:      "with header not matching the block hash, it fails",
+			verifying: true,
+			forge:     "header",
+			wantErr:   ErrVerificationFailed,
+		},
+	}
+
+	hashes := []string{s.rpc.addTx(1), s.rpc.addTx(2)}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			r := s.Require()
+			s.node.verifying = tt.verifying
+			s.rpc.setForge(tt.forge)
+
+			for _, batchSize := range []int{1, 10} {
+				// each case starts without the blocks and the proofs of the previous one
+				s.node.blocks = newBlockCache[*models.Block](maxCachedBlocks)
+				s.node.proofs = newBlockCache[*blockProof](maxCachedBlocks)
+				s.node.batchSize = batchSize
+
+				resChan, err := s.node.ScheduleTask(s.ctx, hashes[1])
+				r.NoError(err)
+				res := <-resChan
+				if tt.wantErr != nil {
+					r.ErrorIs(res.Err, tt.wantErr)
+					continue
+				}
+
+				r.NoError(res.Err)
+				r.Equal(tt.wantVerified, res.Tx.Verified)
+			}
+		})
+	}
+}
+
+func (s *BatchTestSuite) TestBlockReceipts() {
+	tests := []struct {
+		name         string
+		unsupported  bool
+		prewarm      bool
+		wantSiblings int
+		wantReceipts int
+	}{
+		{
+			name:         "with transactions of the same block, all the receipts of the block are fetched at once",
+			wantReceipts: 0,
+		},
+		{
+			name:         "with pre-warming, the other transactions of the block are delivered along",
+			prewarm:      true,
+			wantSiblings: 2,
+		},
+		{
+			name:         "with node not serving the block receipts, the receipts are fetched one by one",
+			unsupported:  true,
+			wantReceipts: 2,
+		},
+	}
+
+	hashes := []string{s.rpc.addTx(1), s.rpc.addTx(2), s.rpc.addTx(3), s.rpc.addTx(4)}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			r := s.Require()
+			s.rpc.blockReceiptsUnsupported = tt.unsupported
+			s.node.blockReceipts.Store(true)
+			s.node.prewarm = tt.prewarm
+			receipts := s.rpc.served("eth_getTransactionReceipt")
+
+			var resChans []<-chan TxResult
+			for _, hash := range hashes[:2] {
+				resChan, err := s.node.ScheduleTask(s.ctx, hash)
+				r.NoError(err)
+				resChans = append(resChans, resChan)
+			}
+
+			var siblings []*store.TxRecord
+			for i, resChan := range resChans {
+				res := <-resChan
+				r.NoError(res.Err)
+				r.Equal(hashes[i], res.Tx.TXHash)
+				r.Len(res.Tx.Logs, 1)
+				r.NotNil(res.Tx.Block)
+				siblings = append(siblings, res.Siblings...)
+			}
+
+			r.Len(siblings, tt.wantSiblings)
+			for _, sibling := range siblings {
+				r.Contains(hashes[2:], sibling.TXHash, "only the other transactions of the block must be pre-warmed")
+				r.NotNil(sibling.Block)
+			}
+			r.Equal(tt.wantReceipts, s.rpc.served("eth_getTransactionReceipt")-receipts)
+			r.Equal(!tt.unsupported, s.node.blockReceipts.Load())
+		})
+	}
+}
+
+func (s *BatchTestSuite) TestBlockTransactions() {
+	r := s.Require()
+
+	hashes := []string{s.rpc.addTx(1), s.rpc.addTx(2)}
+	s.rpc.addPendingTx(3)
+
+	records, err := s.node.BlockTransactions(s.ctx, 5703601)
+	r.NoError(err)
+	r.Len(records, len(hashes), "only the mined transactions must be returned")
+	for i, record := range records {
+		r.Equal(hashes[i], record.TXHash, "the transactions must be returned in the order of the block")
+		r.Equal(s.rpc.sender.Hex(), record.FromAddress)
+		r.Len(record.Logs, 1)
+		r.Equal(int64(fakeBlockTime), record.Block.Timestamp.Unix())
+	}
+	r.Equal(1, s.rpc.served("eth_getBlockByNumber"), "the block body must be fetched once")
+	r.Equal(1, s.rpc.served("eth_getBlockReceipts"), "the block receipts must be fetched at once")
+
+	_, err = s.node.BlockTransactions(s.ctx, 5703602)
+	r.True(IsNotFound(err), "the block beyond the head must not be found")
+}
+
+func (s *BatchTestSuite) TestSendRawTransaction() {
+	s.node.chainID = cmd.SepoliaChainID
+	known := s.rpc.addTx(1)
+
+	encode := func(tx *types.Transaction) []byte {
+		raw, err := tx.MarshalBinary()
+		s.Require().NoError(err)
+		return raw
+	}
+
+	tests := []struct {
+		name    string
+		rawTx   []byte
+		wantErr error
+	}{
+		{
+			name:  "with valid transaction, it is broadcast and returned as pending",
+			rawTx: encode(s.rpc.signTx(cmd.SepoliaChainID, 2)),
+		},
+		{
+			name:  "with transaction already known to the node, it is returned as pending",
+			rawTx: encode(s.rpc.txs[common.HexToHash(known)]),
+		},
+		{
+			name:    "with transaction of another chain, it fails without broadcast",
+			rawTx:   encode(s.rpc.signTx(1, 3)),
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "with broken encoding, it fails without broadcast",
+			rawTx:   []byte{0x02, 0xf8, 0x01},
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "with transaction refused by the node, it fails",
+			rawTx:   encode(s.rpc.signTx(cmd.SepoliaChainID, 0)),
+			wantErr: ErrTransactionRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			r := s.Require()
+			posts := s.rpc.posts()
+
+			record, err := s.node.SendRawTransaction(s.ctx, tt.rawTx)
+			if tt.wantErr != nil {
+				r.ErrorIs(err, tt.wantErr)
+				if errors.Is(tt.wantErr, ErrInvalidTransaction) {
+					r.Equal(posts, s.rpc.posts(), "the invalid transaction must not be broadcast")
+				}
+				return
+			}
+
+			r.NoError(err)
+			r.True(record.Pending)
+			r.Equal(s.rpc.sender.Hex(), record.FromAddress, "the sender must be recovered from the signature")
+			r.Equal(int64(cmd.SepoliaChainID), record.ChainID)
+			r.Contains(s.rpc.txs, common.HexToHash(record.TXHash), "the transaction must be known to the node")
+		})
+	}
+}
+
+func (s *BatchTestSuite) tokens() float64 {
+	s.node.rateLimiter.mu.Lock()
+	defer s.node.rateLimiter.mu.Unlock()
+	return s.node.rateLimiter.tokens
+}
+
+func TestBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchTestSuite))
+}
+
+// fakeBlockTime is the timestamp of the block, where the transactions of the fake node are mined
+const fakeBlockTime = 1714000000
+
+// fakeRPC is a minimal json-rpc node that knows a few signed transactions and their receipts
+type fakeRPC struct {
+	*httptest.Server
+	t        *testing.T
+	mu       sync.Mutex
+	sender   common.Address
+	key      *ecdsa.PrivateKey
+	txs      map[common.Hash]*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+	postCnt  int
+	calls    map[string]int
+	// traceSupported serves the debug namespace, otherwise its methods do not exist
+	traceSupported bool
+	// block is the sealed block of all the mined transactions, with valid roots of its receipts
+	block         *types.Block
+	blockReceipts types.Receipts
+	// forge alters the served receipt, the block receipts or the block header
+	forge string
+	// blockReceiptsUnsupported does not serve all the receipts of the block at once
+	blockReceiptsUnsupported bool
+}
+
+type fakeRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func newFakeRPC(t *testing.T) *fakeRPC {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeRPC{
+		t:        t,
+		sender:   crypto.PubkeyToAddress(key.PublicKey),
+		key:      key,
+		txs:      make(map[common.Hash]*types.Transaction),
+		receipts: make(map[common.Hash]*types.Receipt),
+		calls:    make(map[string]int),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	return f
+}
+
+// addTx signs a new transaction with the provided nonce, mines it and returns its hash
+func (f *fakeRPC) addTx(nonce uint64) string {
+	tx := f.signTx(11155111, nonce)
+	to := *tx.To()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.txs[tx.Hash()] = tx
+	f.receipts[tx.Hash()] = &types.Receipt{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs: []*types.Log{{
+			Address:     to,
+			Topics:      []common.Hash{common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			Data:        []byte{0x01},
+			BlockNumber: 5703601,
+			TxHash:      tx.Hash(),
+			Index:       7,
+		}},
+		TxHash:            tx.Hash(),
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(1000),
+		BlockNumber:       big.NewInt(5703601),
+	}
+	f.seal()
+	return tx.Hash().Hex()
+}
+
+// signTx signs a new transaction of the chain with the provided nonce, without adding it to the node
+func (f *fakeRPC) signTx(chainID int64, nonce uint64) *types.Transaction {
+	to := common.HexToAddress("0xAa449E0226B45D2044B1f721D04001fDe02ABb08")
+	signer := types.LatestSignerForChainID(big.NewInt(chainID))
+	tx, err := types.SignNewTx(f.key, signer, &types.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(500),
+	})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return tx
+}
+
+// addPendingTx signs a new transaction that is not mined yet, i.e. it has no receipt
+func (f *fakeRPC) addPendingTx(nonce uint64) string {
+	hash := f.addTx(nonce)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.receipts, common.HexToHash(hash))
+	f.seal()
+	return hash
+}
+
+// seal seals the mined transactions into the block with valid transactions and receipts roots
+func (f *fakeRPC) seal() {
+	txs := make(types.Transactions, 0, len(f.receipts))
+	for hash := range f.receipts {
+		txs = append(txs, f.txs[hash])
+	}
+	slices.SortFunc(txs, func(a, b *types.Transaction) int {
+		return cmp.Compare(a.Nonce(), b.Nonce())
+	})
+
+	receipts := make(types.Receipts, 0, len(txs))
+	for i, tx := range txs {
+		receipt := f.receipts[tx.Hash()]
+		receipt.TransactionIndex = uint(i)
+		receipt.CumulativeGasUsed = uint64(i+1) * receipt.GasUsed
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		receipts = append(receipts, receipt)
+	}
+
+	f.block = types.NewBlock(&types.Header{
+		ParentHash: common.HexToHash("0x01"),
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(5703601),
+		Time:       fakeBlockTime,
+		BaseFee:    big.NewInt(7),
+	}, &types.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	for _, receipt := range receipts {
+		receipt.BlockHash = f.block.Hash()
+		for _, l := range receipt.Logs {
+			l.BlockHash = f.block.Hash()
+		}
+	}
+	f.blockReceipts = receipts
+}
+
+func (f *fakeRPC) setForge(forge string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forge = forge
+}
+
+// transaction returns the json of the transaction, along with its block once it is mined
+func (f *fakeRPC) transaction(tx *types.Transaction) map[string]any {
+	raw, err := json.Marshal(tx)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	res := make(map[string]any)
+	if err = json.Unmarshal(raw, &res); err != nil {
+		f.t.Fatal(err)
+	}
+	if receipt, found := f.receipts[tx.Hash()]; found {
+		res["blockHash"] = receipt.BlockHash
+		res["blockNumber"] = hexutil.EncodeBig(receipt.BlockNumber)
+		res["transactionIndex"] = hexutil.Uint(receipt.TransactionIndex)
+	}
+	return res
+}
+
+// sealedBlock returns the json of the sealed block with its transactions, as the node serves it
+func (f *fakeRPC) sealedBlock() map[string]any {
+	header := f.block.Header()
+	if f.forge == "header" {
+		header.Time++
+	}
+
+	raw, err := json.Marshal(header)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	block := make(map[string]any)
+	if err = json.Unmarshal(raw, &block); err != nil {
+		f.t.Fatal(err)
+	}
+	block["hash"] = f.block.Hash()
+	block["transactions"] = f.block.Transactions()
+	block["uncles"] = []common.Hash{}
+	return block
+}
+
+// served returns how many times the method was called
+func (f *fakeRPC) served(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+func (f *fakeRPC) posts() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.postCnt
+}
+
+func (f *fakeRPC) serve(w http.ResponseWriter, r *http.Request) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	f.postCnt++
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if raw[0] != '[' {
+		var req fakeRPCRequest
+		_ = json.Unmarshal(raw, &req)
+		_ = json.NewEncoder(w).Encode(f.handle(req))
+		return
+	}
+
+	var reqs []fakeRPCRequest
+	_ = json.Unmarshal(raw, &reqs)
+	res := make([]map[string]any, 0, len(reqs))
+	for _, req := range reqs {
+		res = append(res, f.handle(req))
+	}
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (f *fakeRPC) handle(req fakeRPCRequest) map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls[req.Method]++
+	res := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": nil}
+
+	var hash common.Hash
+	if len(req.Params) > 0 {
+		_ = json.Unmarshal(req.Params[0], &hash)
+	}
+
+	switch req.Method {
+	case "eth_blockNumber":
+		res["result"] = "0x5707b1"
+	case "eth_getTransactionByHash":
+		if tx, found := f.txs[hash]; found {
+			res["result"] = f.transaction(tx)
+		}
+	case "eth_getTransactionReceipt":
+		if receipt, found := f.receipts[hash]; found {
+			if f.forge == "receipt" {
+				forged := *receipt
+				forged.Status = types.ReceiptStatusFailed
+				receipt = &forged
+			}
+			res["result"] = receipt
+		}
+	case "eth_getBlockReceipts":
+		if f.blockReceiptsUnsupported {
+			res["error"] = map[string]any{"code": -32601, "message": "the method " + req.Method + " does not exist"}
+			delete(res, "result")
+			break
+		}
+		if f.block != nil && hash == f.block.Hash() {
+			receipts := f.blockReceipts
+			if f.forge == "receipts" {
+				forged := *receipts[0]
+				forged.CumulativeGasUsed++
+				receipts = append(types.Receipts{&forged}, receipts[1:]...)
+			}
+			res["result"] = receipts
+		}
+	case "eth_getBlockByHash":
+		if f.block != nil && hash == f.block.Hash() {
+			res["result"] = f.sealedBlock()
+			break
+		}
+		res["result"] = &types.Header{
+			ParentHash: common.HexToHash("0x01"),
+			Difficulty: big.NewInt(0),
+			Number:     big.NewInt(5703601),
+			Time:       fakeBlockTime,
+			BaseFee:    big.NewInt(7),
+		}
+	case "eth_getBlockByNumber":
+		var number hexutil.Uint64
+		_ = json.Unmarshal(req.Params[0], &number)
+		if f.block != nil && uint64(number) == f.block.NumberU64() {
+			res["result"] = f.sealedBlock()
+		}
+	case "eth_sendRawTransaction":
+		var raw hexutil.Bytes
+		_ = json.Unmarshal(req.Params[0], &raw)
+		tx := new(types.Transaction)
+		_ = tx.UnmarshalBinary(raw)
+		switch _, known := f.txs[tx.Hash()]; {
+		case known:
+			res["error"] = map[string]any{"code": -32000, "message": "already known"}
+			delete(res, "result")
+		case tx.Nonce() == 0:
+			res["error"] = map[string]any{"code": -32000, "message": "nonce too low: next nonce 1, tx nonce 0"}
+			delete(res, "result")
+		default:
+			// the broadcast transaction waits in the mempool
+			f.txs[tx.Hash()] = tx
+			res["result"] = tx.Hash()
+		}
+	case "debug_traceTransaction":
+		if tx, found := f.txs[hash]; found && f.traceSupported {
+			res["result"] = map[string]any{
+				"type": "CALL", "from": f.sender, "to": tx.To(), "value": "0x1f4", "gas": "0x5208",
+				"calls": []map[string]any{{
+					"type": "CALL", "from": tx.To(), "to": "0xd5e6f34bbd4251195c03e7bf3660677ed2315f70",
+					"value": "0xde0b6b3a7640000", "gas": "0x8fc",
+					"calls": []map[string]any{{
+						"type": "STATICCALL", "from": "0xd5e6f34bbd4251195c03e7bf3660677ed2315f70",
+						"to": tx.To(), "gas": "0x64", "error": "execution reverted",
+					}},
+				}},
+			}
+			break
+		}
+		fallthrough
+	default:
+		res["error"] = map[string]any{"code": -32601, "message": "the method " + req.Method + " does not exist"}
+		delete(res, "result")
+	}
+
+	return res
+}
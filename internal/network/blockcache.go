@@ -0,0 +1,74 @@
+package network
+
+import (
+	"context"
+	"sync"
+)
+
+// maxCachedBlocks limits the count of the blocks kept in memory
+const maxCachedBlocks = 1024
+
+// blockCache keeps the recently fetched data of the blocks by hash, so the transactions of the same block share
+// a single lookup; concurrent lookups of the same hash wait for the one already in progress
+type blockCache[T any] struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*blockEntry[T]
+	order   []string
+}
+
+type blockEntry[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newBlockCache[T any](size int) *blockCache[T] {
+	return &blockCache[T]{
+		size:    size,
+		entries: make(map[string]*blockEntry[T]),
+	}
+}
+
+// get returns the cached value or fetches it, the failed fetches are not cached
+func (c *blockCache[T]) get(ctx context.Context, blockHash string,
+	fetch func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+	if entry, found := c.entries[blockHash]; found {
+		c.mu.Unlock()
+
+		select {
+		case <-entry.done:
+			if entry.err != nil {
+				// the lookup in progress failed, possibly due to its own context - try again
+				return c.get(ctx, blockHash, fetch)
+			}
+			return entry.value, nil
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+
+	entry := &blockEntry[T]{done: make(chan struct{})}
+	c.entries[blockHash] = entry
+	c.mu.Unlock()
+
+	entry.value, entry.err = fetch(ctx)
+
+	c.mu.Lock()
+	if entry.err != nil {
+		delete(c.entries, blockHash)
+	} else {
+		c.order = append(c.order, blockHash)
+		// evict the oldest blocks, the recent ones are the most likely to be looked up again
+		for len(c.order) > c.size {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+	}
+	c.mu.Unlock()
+	close(entry.done)
+
+	return entry.value, entry.err
+}
@@ -0,0 +1,340 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// json-rpc method used to fetch all the receipts of the block at once
+const methodBlockReceipts = "eth_getBlockReceipts"
+
+// minBlockGroup is the count of the batched transactions of the same block, from which the body and all the
+// receipts of the block are fetched, instead of the receipt of each transaction
+const minBlockGroup = 2
+
+// blockGroup is the batched transactions of the same block, fetched along with its body and all of its receipts
+type blockGroup struct {
+	hash        common.Hash
+	tasks       []int
+	elem        int
+	rawBlock    json.RawMessage
+	rawReceipts json.RawMessage
+}
+
+// rpcBlockBody is the part of the block json, the header is decoded on its own
+type rpcBlockBody struct {
+	Hash         common.Hash          `json:"hash"`
+	Transactions []*types.Transaction `json:"transactions"`
+}
+
+// rpcTransactionBlock is the part of the transaction json, telling the block it is mined in
+type rpcTransactionBlock struct {
+	BlockHash *common.Hash `json:"blockHash"`
+}
+
+// fetchReceipts fetches the receipts of the batched mined transactions with a single json-rpc batch call - the
+// transactions of the same block share the call of its body and all of its receipts, the other ones are fetched
+// one by one; the results of the tasks are set in place
+func (n *EthNode) fetchReceipts(ctx context.Context, tasks []TxTask, ethTXs []*types.Transaction,
+	blockHashes []*common.Hash, results []TxResult) {
+	groups := make([]*blockGroup, 0)
+	byHash := make(map[common.Hash]*blockGroup)
+	for i, blockHash := range blockHashes {
+		if blockHash == nil {
+			continue
+		}
+		group, found := byHash[*blockHash]
+		if !found {
+			group = &blockGroup{hash: *blockHash, elem: -1}
+			byHash[*blockHash] = group
+			groups = append(groups, group)
+		}
+		group.tasks = append(group.tasks, i)
+	}
+	if len(groups) == 0 {
+		return
+	}
+
+	receipts := make([]json.RawMessage, len(tasks))
+	receiptElems := make(map[int]int)
+	elems := make([]rpc.BatchElem, 0, len(tasks))
+	for _, group := range groups {
+		if len(group.tasks) >= minBlockGroup && n.blockReceipts.Load() {
+			group.elem = len(elems)
+			elems = append(elems,
+				rpc.BatchElem{Method: methodBlockByHash, Args: []any{group.hash, true}, Result: &group.rawBlock},
+				rpc.BatchElem{Method: methodBlockReceipts, Args: []any{group.hash}, Result: &group.rawReceipts},
+			)
+			continue
+		}
+		for _, i := range group.tasks {
+			receiptElems[i] = len(elems)
+			elems = append(elems, rpc.BatchElem{Method: methodTransactionReceipt, Args: []any{ethTXs[i].Hash()},
+				Result: &receipts[i]})
+		}
+	}
+
+	if err := n.batchCall(ctx, elems); err != nil {
+		for _, group := range groups {
+			for _, i := range group.tasks {
+				results[i].Err = fmt.Errorf("failed to fetch receipts batch: %w", err)
+			}
+		}
+		return
+	}
+
+	for _, group := range groups {
+		if group.elem < 0 {
+			for _, i := range group.tasks {
+				n.completeReceipt(ctx, tasks[i], ethTXs[i], elems[receiptElems[i]], receipts[i], &results[i])
+			}
+			continue
+		}
+		n.completeGroup(ctx, tasks, ethTXs, group, elems, results)
+	}
+}
+
+// completeReceipt sets the result of the transaction fetched with its own receipt
+func (n *EthNode) completeReceipt(ctx context.Context, task TxTask, ethTX *types.Transaction, elem rpc.BatchElem,
+	raw json.RawMessage, result *TxResult) {
+	receipt := new(types.Receipt)
+	if err := decodeBatchResult(elem, raw, receipt); err != nil {
+		switch err = classified(err); {
+		case IsNotFound(err):
+			// the block of the transaction got reorged meanwhile, it waits in the mempool again
+			result.Tx, result.Err = n.newTransaction(ethTX, nil)
+		case errors.Is(err, ErrTransient):
+			// failures of single calls inside the batch (e.g. 429) are retried outside of it
+			result.Tx, result.Err = n.GetTransactionByHash(task)
+		default:
+			result.Err = fmt.Errorf("failed to fetch transaction receipt: %w", err)
+		}
+		return
+	}
+
+	result.Tx, result.Err = n.minedRecord(ctx, ethTX, receipt)
+}
+
+// completeGroup sets the results of the transactions of the same block, from its body and its receipts; when
+// the block cannot be served that way, the transactions are fetched one by one
+func (n *EthNode) completeGroup(ctx context.Context, tasks []TxTask, ethTXs []*types.Transaction, group *blockGroup,
+	elems []rpc.BatchElem, results []TxResult) {
+	block, blockReceipts, err := decodeBlockGroup(elems[group.elem], group.rawBlock, elems[group.elem+1],
+		group.rawReceipts)
+	if err == nil {
+		err = n.cacheBlock(ctx, group.hash, block, blockReceipts)
+	}
+	if errors.Is(err, ErrVerificationFailed) {
+		for _, i := range group.tasks {
+			results[i].Err = err
+		}
+		return
+	}
+	if err != nil {
+		if isMethodUnsupported(err) && n.blockReceipts.CompareAndSwap(true, false) {
+			log.Warnf("the node of chain %d does not support %s, the receipts are fetched one by one: %v",
+				n.chainID, methodBlockReceipts, err)
+		}
+		for _, i := range group.tasks {
+			results[i].Tx, results[i].Err = n.GetTransactionByHash(tasks[i])
+		}
+		return
+	}
+
+	receiptsByHash := make(map[common.Hash]*types.Receipt, len(blockReceipts))
+	for _, receipt := range blockReceipts {
+		receiptsByHash[receipt.TxHash] = receipt
+	}
+
+	fetched := make(map[common.Hash]struct{}, len(group.tasks))
+	for _, i := range group.tasks {
+		fetched[ethTXs[i].Hash()] = struct{}{}
+
+		receipt, found := receiptsByHash[ethTXs[i].Hash()]
+		if !found {
+			// the block got reorged meanwhile, the transaction is fetched on its own
+			results[i].Tx, results[i].Err = n.GetTransactionByHash(tasks[i])
+			continue
+		}
+		results[i].Tx, results[i].Err = n.minedRecord(ctx, ethTXs[i], receipt)
+	}
+
+	if n.prewarm {
+		// the other transactions of the block are delivered along with the first transaction of the group
+		siblings, err := n.blockRecords(ctx, block, blockReceipts, fetched)
+		if err != nil {
+			log.Warnf("cannot pre-warm the transactions of block %s: %v", group.hash.Hex(), err)
+			return
+		}
+		results[group.tasks[0]].Siblings = siblings
+	}
+}
+
+// BlockTransactions returns all the transactions of the canonical block at the provided height, the body and
+// all the receipts of the block are fetched once for all of them; the transactions are not traced
+func (n *EthNode) BlockTransactions(ctx context.Context, number uint64) ([]*store.TxRecord, error) {
+	var raw json.RawMessage
+	err := n.callNode(ctx, methodBlockByNumber, func(client *ethclient.Client) error {
+		return client.Client().CallContext(ctx, &raw, methodBlockByNumber, hexutil.EncodeUint64(number), true)
+	})
+	if err == nil && (len(raw) == 0 || string(raw) == "null") {
+		err = classified(ethereum.NotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", number, err)
+	}
+
+	blockHash, block, err := decodeBlock(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode block %d: %w", number, err)
+	}
+
+	blockReceipts, err := n.fetchBlockReceipts(ctx, blockHash, block.Transactions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipts of block %d: %w", number, err)
+	}
+
+	if err = n.cacheBlock(ctx, blockHash, block, blockReceipts); err != nil {
+		return nil, err
+	}
+	return n.blockRecords(ctx, block, blockReceipts, nil)
+}
+
+// fetchBlockReceipts fetches all the receipts of the block at once, or one by one with a single batch call,
+// when the node does not serve them at once
+func (n *EthNode) fetchBlockReceipts(ctx context.Context, blockHash common.Hash, txs types.Transactions) (
+	types.Receipts, error) {
+	var blockReceipts types.Receipts
+	if n.blockReceipts.Load() {
+		err := n.callNode(ctx, methodBlockReceipts, func(client *ethclient.Client) error {
+			var err error
+			blockReceipts, err = client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(blockHash, false))
+			return err
+		})
+		if err == nil || !isMethodUnsupported(err) {
+			return blockReceipts, err
+		}
+		if n.blockReceipts.CompareAndSwap(true, false) {
+			log.Warnf("the node of chain %d does not support %s, the receipts are fetched one by one: %v",
+				n.chainID, methodBlockReceipts, err)
+		}
+	}
+
+	blockReceipts = make(types.Receipts, len(txs))
+	elems := make([]rpc.BatchElem, 0, len(txs))
+	for i, ethTX := range txs {
+		elems = append(elems, rpc.BatchElem{Method: methodTransactionReceipt, Args: []any{ethTX.Hash()},
+			Result: &blockReceipts[i]})
+	}
+	if err := n.batchCall(ctx, elems); err != nil {
+		return nil, err
+	}
+	for i, elem := range elems {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("failed to fetch transaction receipt: %w", classified(elem.Error))
+		}
+		if blockReceipts[i] == nil {
+			return nil, fmt.Errorf("failed to fetch transaction receipt: %w", classified(ethereum.NotFound))
+		}
+	}
+	return blockReceipts, nil
+}
+
+// cacheBlock keeps the header of the fetched block for the transactions fetched later, and when the verification
+// is on, the proof rebuilt from its body and receipts
+func (n *EthNode) cacheBlock(ctx context.Context, blockHash common.Hash, block *types.Block,
+	blockReceipts types.Receipts) error {
+	if n.verifying {
+		_, err := n.proofs.get(ctx, blockHash.Hex(), func(_ context.Context) (*blockProof, error) {
+			return n.newBlockProof(blockHash, block, blockReceipts)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := n.blocks.get(ctx, blockHash.Hex(), func(_ context.Context) (*models.Block, error) {
+		return n.newBlock(blockHash, block.Header()), nil
+	})
+	return err
+}
+
+// blockRecords converts the transactions of the block, apart from the skipped ones, into their records
+func (n *EthNode) blockRecords(ctx context.Context, block *types.Block, blockReceipts types.Receipts,
+	skip map[common.Hash]struct{}) ([]*store.TxRecord, error) {
+	receiptsByHash := make(map[common.Hash]*types.Receipt, len(blockReceipts))
+	for _, receipt := range blockReceipts {
+		receiptsByHash[receipt.TxHash] = receipt
+	}
+
+	records := make([]*store.TxRecord, 0, len(block.Transactions()))
+	for _, ethTX := range block.Transactions() {
+		if _, skipped := skip[ethTX.Hash()]; skipped {
+			continue
+		}
+
+		receipt, found := receiptsByHash[ethTX.Hash()]
+		if !found {
+			return nil, fmt.Errorf("receipt of transaction '%s' is missing", ethTX.Hash().Hex())
+		}
+		record, err := n.newTransaction(ethTX, receipt)
+		if err != nil {
+			return nil, err
+		}
+		if err = n.verifyReceipt(ctx, record, ethTX, receipt); err != nil {
+			return nil, err
+		}
+		if err = n.attachBlock(ctx, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// decodeBlockGroup decodes the body and the receipts of the block fetched by the batch call
+func decodeBlockGroup(blockElem rpc.BatchElem, rawBlock json.RawMessage, receiptsElem rpc.BatchElem,
+	rawReceipts json.RawMessage) (*types.Block, types.Receipts, error) {
+	if err := decodeBatchResult(blockElem, rawBlock, new(json.RawMessage)); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch block: %w", classified(err))
+	}
+	var blockReceipts types.Receipts
+	if err := decodeBatchResult(receiptsElem, rawReceipts, &blockReceipts); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch block receipts: %w", classified(err))
+	}
+
+	_, block, err := decodeBlock(rawBlock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+	return block, blockReceipts, nil
+}
+
+// decodeBlock decodes the block json with full transactions, along with the block hash reported by the node
+func decodeBlock(raw json.RawMessage) (common.Hash, *types.Block, error) {
+	header := new(types.Header)
+	if err := json.Unmarshal(raw, header); err != nil {
+		return common.Hash{}, nil, err
+	}
+	var body rpcBlockBody
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	block := types.NewBlockWithHeader(header).WithBody(types.Body{Transactions: body.Transactions})
+	return body.Hash, block, nil
+}
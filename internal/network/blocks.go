@@ -0,0 +1,133 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/volatiletech/null/v8"
+	boilTypes "github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// json-rpc methods used to follow the chain head
+const (
+	methodBlockNumber   = "eth_blockNumber"
+	methodBlockByNumber = "eth_getBlockByNumber"
+	methodBlockByHash   = "eth_getBlockByHash"
+)
+
+// HeadBlockNumber returns the number of the most recent block
+func (n *EthNode) HeadBlockNumber(ctx context.Context) (uint64, error) {
+	var head uint64
+	err := n.callNode(ctx, methodBlockNumber, func(client *ethclient.Client) error {
+		var err error
+		head, err = client.BlockNumber(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch head block number: %w", err)
+	}
+	return head, nil
+}
+
+// FinalizedBlockNumber returns the number of the most recent block, that cannot be reorged anymore
+func (n *EthNode) FinalizedBlockNumber(ctx context.Context) (uint64, error) {
+	header, err := n.headerByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch finalized block: %w", err)
+	}
+	return header.Number.Uint64(), nil
+}
+
+// BlockHashByNumber returns the hash of the canonical block at the provided height
+func (n *EthNode) BlockHashByNumber(ctx context.Context, number uint64) (string, error) {
+	header, err := n.headerByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch block %d: %w", number, err)
+	}
+	return header.Hash().Hex(), nil
+}
+
+// attachBlock adds the block of the mined transaction to its record, the pending ones don't have block yet
+func (n *EthNode) attachBlock(ctx context.Context, record *store.TxRecord) error {
+	if record == nil || !record.BlockHash.Valid {
+		return nil
+	}
+
+	blockHash := common.HexToHash(record.BlockHash.String)
+	block, err := n.blocks.get(ctx, record.BlockHash.String, func(ctx context.Context) (*models.Block, error) {
+		header, err := n.headerByHash(ctx, blockHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch block %s: %w", record.BlockHash.String, err)
+		}
+		if n.verifying && header.Hash() != blockHash {
+			return nil, n.verificationFailed("the header of block %s hashes to %s", blockHash.Hex(),
+				header.Hash().Hex())
+		}
+		return n.newBlock(blockHash, header), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// each record gets its own copy, so they can be stored independently
+	blockCopy := *block
+	record.Block = &blockCopy
+	return nil
+}
+
+// newBlock converts the header of the block with the provided hash into the stored model
+func (n *EthNode) newBlock(blockHash common.Hash, header *types.Header) *models.Block {
+	block := &models.Block{
+		ChainID:     n.chainID,
+		BlockHash:   blockHash.Hex(),
+		BlockNumber: boilTypes.NewDecimal(new(decimal.Big).SetBigMantScale(header.Number, 0)),
+		ParentHash:  header.ParentHash.Hex(),
+		// nolint:gosec // the block timestamp fits in int64 for the next billions of years
+		Timestamp: time.Unix(int64(header.Time), 0).UTC(),
+		Miner:     header.Coinbase.Hex(),
+	}
+	if header.BaseFee != nil {
+		block.BaseFeePerGas = null.StringFrom(header.BaseFee.String())
+	}
+	return block
+}
+
+func (n *EthNode) headerByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	var header *types.Header
+	err := n.callNode(ctx, methodBlockByHash, func(client *ethclient.Client) error {
+		var err error
+		header, err = client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (n *EthNode) headerByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *types.Header
+	err := n.callNode(ctx, methodBlockByNumber, func(client *ethclient.Client) error {
+		var err error
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+// callNode calls the node through the pool, obeying the rate limitations and retrying the transient failures
+func (n *EthNode) callNode(ctx context.Context, method string, call func(client *ethclient.Client) error) error {
+	return n.retry.Do(ctx, func() error {
+		if err := n.rateLimiter.Wait(ctx, n.rateLimiter.Cost(method)); err != nil {
+			return err
+		}
+		return n.pool.Do(ctx, call)
+	})
+}
@@ -0,0 +1,74 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"ethereum-fetcher/internal/store"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// json-rpc method used to broadcast the signed transactions
+const methodSendRawTransaction = "eth_sendRawTransaction"
+
+var (
+	// ErrInvalidTransaction is returned when the raw transaction cannot be decoded or does not belong to the chain
+	ErrInvalidTransaction = errors.New("invalid transaction")
+	// ErrTransactionRejected is returned when the node refuses to accept the transaction, e.g. its nonce is too low
+	ErrTransactionRejected = errors.New("transaction rejected by the node")
+)
+
+// SendRawTransaction validates the signed raw transaction (its encoding, chain ID and sender), broadcasts it
+// and returns it as pending record; broadcasting the transaction already known to the node is not a failure
+func (n *EthNode) SendRawTransaction(ctx context.Context, rawTx []byte) (*store.TxRecord, error) {
+	ethTX := new(types.Transaction)
+	if err := ethTX.UnmarshalBinary(rawTx); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+
+	if !ethTX.Protected() {
+		return nil, fmt.Errorf("%w: the transaction is not replay protected by chain ID", ErrInvalidTransaction)
+	}
+	if chainID := ethTX.ChainId(); !chainID.IsInt64() || chainID.Int64() != n.chainID {
+		return nil, fmt.Errorf("%w: chain ID %s does not match the chain %d", ErrInvalidTransaction, chainID,
+			n.chainID)
+	}
+
+	// the pending record recovers the sender, so a broken signature is found before the broadcast
+	record, err := n.newTransaction(ethTX, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+
+	err = n.callNode(ctx, methodSendRawTransaction, func(client *ethclient.Client) error {
+		return client.SendTransaction(ctx, ethTX)
+	})
+	switch {
+	case err == nil, isAlreadyKnown(err):
+		return record, nil
+	case errors.Is(err, ErrTransient):
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	default:
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			return nil, fmt.Errorf("%w: %s", ErrTransactionRejected, rpcErr.Error())
+		}
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+}
+
+// isAlreadyKnown reports whether the node rejected the transaction, since it is already in its mempool
+func isAlreadyKnown(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	message := strings.ToLower(rpcErr.Error())
+	return strings.Contains(message, "already known") || strings.Contains(message, "known transaction")
+}
@@ -0,0 +1,179 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/network/fixture"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// ErrUnknownChain is returned for chains without configured node
+var ErrUnknownChain = errors.New("unknown chain")
+
+// chainNames are the well-known names of the chains, accepted instead of their IDs
+var chainNames = map[string]int64{
+	"mainnet":  1,
+	"optimism": 10,
+	"polygon":  137,
+	"base":     8453,
+	"holesky":  17000,
+	"arbitrum": 42161,
+	"sepolia":  11155111,
+}
+
+// ChainsProvider selects the ethereum provider of the chain
+//
+//go:generate mockery --name ChainsProvider
+type ChainsProvider interface {
+	Chain(chainID int64) (EthereumProvider, error)
+	ChainIDs() []int64
+	DefaultChainID() int64
+}
+
+// Chains holds the ethereum provider of each configured chain
+type Chains struct {
+	defaultChainID int64
+	nodes          map[int64]EthereumProvider
+}
+
+// NewChains creates an ethereum provider for each chain with configured node urls; ETH_NODE_URL serves
+// the default chain. With NODE_REPLAY_DIR, the chains are served by the replayed fixtures instead
+func NewChains(ctx context.Context, vp *viper.Viper) *Chains {
+	defaultChainID := vp.GetInt64(cmd.DefaultChainID)
+
+	var chainURLs map[int64][]string
+	var err error
+	if replayDir := vp.GetString(cmd.NodeReplayDir); replayDir != "" {
+		if chainURLs, err = ReplayChainNodeURLs(ctx, replayDir); err != nil {
+			log.Fatalf("Failed to replay the node fixtures: %v", err)
+		}
+	} else {
+		if chainURLs, err = ParseChainNodeURLs(vp.GetString(cmd.ChainNodeURLs)); err != nil {
+			log.Fatalf("Failed to parse the chain node urls: %v", err)
+		}
+		if urls := ParseNodeURLs(vp.GetString(cmd.EthNodeURL)); len(urls) > 0 {
+			chainURLs[defaultChainID] = append(urls, chainURLs[defaultChainID]...)
+		}
+	}
+	if len(chainURLs[defaultChainID]) == 0 {
+		log.Fatalf("No node url configured for the default chain %d", defaultChainID)
+	}
+
+	chains := &Chains{
+		defaultChainID: defaultChainID,
+		nodes:          make(map[int64]EthereumProvider, len(chainURLs)),
+	}
+	for chainID, urls := range chainURLs {
+		chains.nodes[chainID] = NewEthNode(ctx, vp, chainID, urls)
+	}
+
+	return chains
+}
+
+// Chain returns the ethereum provider of the chain
+func (c *Chains) Chain(chainID int64) (EthereumProvider, error) {
+	node, found := c.nodes[chainID]
+	if !found {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownChain, chainID)
+	}
+	return node, nil
+}
+
+// ChainIDs returns the sorted IDs of all configured chains
+func (c *Chains) ChainIDs() []int64 {
+	chainIDs := make([]int64, 0, len(c.nodes))
+	for chainID := range c.nodes {
+		chainIDs = append(chainIDs, chainID)
+	}
+	slices.Sort(chainIDs)
+	return chainIDs
+}
+
+// DefaultChainID returns the chain used by the routes without chain
+func (c *Chains) DefaultChainID() int64 {
+	return c.defaultChainID
+}
+
+// ParseChainID parses the chain ID or the well-known name of the chain, e.g. "1" or "mainnet"
+func ParseChainID(chain string) (int64, error) {
+	if chainID, found := chainNames[strings.ToLower(chain)]; found {
+		return chainID, nil
+	}
+
+	chainID, err := strconv.ParseInt(chain, 10, 64)
+	if err != nil || chainID <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownChain, chain)
+	}
+	return chainID, nil
+}
+
+// ParseChainNodeURLs parses semicolon separated list of chain=urls pairs, where the urls are comma separated,
+// e.g. "1=https://a,https://b;optimism=https://c"
+func ParseChainNodeURLs(list string) (map[int64][]string, error) {
+	chainURLs := make(map[int64][]string)
+	for _, pair := range strings.Split(list, ";") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		chain, urls, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid chain node urls '%s', expected chain=urls", pair)
+		}
+
+		chainID, err := ParseChainID(strings.TrimSpace(chain))
+		if err != nil {
+			return nil, err
+		}
+		chainURLs[chainID] = append(chainURLs[chainID], ParseNodeURLs(urls)...)
+	}
+	return chainURLs, nil
+}
+
+// compile-time check to ensure the types implement the interfaces
+var (
+	_ ChainsProvider   = &Chains{}
+	_ EthereumProvider = &EthNode{}
+)
+
+// ReplayChainNodeURLs starts the fake node of each chain directory (named by the chain ID) of the recorded
+// fixtures and returns their urls; the fake nodes are stopped once the context is done
+func ReplayChainNodeURLs(ctx context.Context, dir string) (map[int64][]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read fixtures: %w", err)
+	}
+
+	chainURLs := make(map[int64][]string)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		chainID, err := ParseChainID(entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain directory %s: %w", entry.Name(), err)
+		}
+
+		server, err := fixture.NewServer(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			<-ctx.Done()
+			server.Close()
+		}()
+		chainURLs[chainID] = []string{server.URL}
+	}
+
+	return chainURLs, nil
+}
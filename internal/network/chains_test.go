@@ -0,0 +1,58 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChainsTestSuite struct {
+	suite.Suite
+}
+
+func (s *ChainsTestSuite) TestParseChainID() {
+	tests := []struct {
+		chain   string
+		want    int64
+		wantErr bool
+	}{
+		{chain: "1", want: 1},
+		{chain: "mainnet", want: 1},
+		{chain: "Sepolia", want: 11155111},
+		{chain: "42161", want: 42161},
+		{chain: "0", wantErr: true},
+		{chain: "-5", wantErr: true},
+		{chain: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.chain, func() {
+			chainID, err := ParseChainID(tt.chain)
+			if tt.wantErr {
+				s.ErrorIs(err, ErrUnknownChain)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tt.want, chainID)
+		})
+	}
+}
+
+func (s *ChainsTestSuite) TestParseChainNodeURLs() {
+	chainURLs, err := ParseChainNodeURLs(" 1=https://a, https://b ; optimism=https://c;; 1=https://d")
+	s.Require().NoError(err)
+	s.Equal(map[int64][]string{
+		1:  {"https://a", "https://b", "https://d"},
+		10: {"https://c"},
+	}, chainURLs)
+
+	_, err = ParseChainNodeURLs("https://a")
+	s.Error(err, "chain must be provided")
+
+	_, err = ParseChainNodeURLs("unknown=https://a")
+	s.ErrorIs(err, ErrUnknownChain)
+}
+
+func TestChainsTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainsTestSuite))
+}
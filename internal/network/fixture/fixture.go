@@ -0,0 +1,105 @@
+// Package fixture records the json-rpc calls of a real ethereum node into fixture files and replays them
+// by an in-process fake node, so the ethereum provider runs unchanged without network access
+package fixture
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fixtureExt is the extension of the fixture files, the rest of the files in the directory are ignored
+const fixtureExt = ".json"
+
+// Call is a single recorded json-rpc call, along with either its result or its error
+type Call struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// Error is the json-rpc error returned by the node
+type Error struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// rpcMessage is either the json-rpc request or the response, depending on the present fields
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// callKey identifies the call by its method and (compacted) params, so the same call always maps to the same file
+func callKey(method string, params json.RawMessage) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, params); err != nil || compacted.String() == "null" {
+		compacted.Reset()
+		compacted.WriteString("[]")
+	}
+
+	hash := sha256.Sum256([]byte(method + compacted.String()))
+	return method + "-" + hex.EncodeToString(hash[:8])
+}
+
+// Load reads all fixture files of the directory, mapped by their call key
+func Load(dir string) (map[string]*Call, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read fixtures: %w", err)
+	}
+
+	calls := make(map[string]*Call, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fixtureExt) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("cannot read fixture %s: %w", entry.Name(), err)
+		}
+		call := new(Call)
+		if err = json.Unmarshal(data, call); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", entry.Name(), err)
+		}
+		calls[callKey(call.Method, call.Params)] = call
+	}
+
+	return calls, nil
+}
+
+// save writes the call into its fixture file, overwriting the previous recording of the same call
+func save(dir string, call *Call) error {
+	data, err := json.MarshalIndent(call, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, callKey(call.Method, call.Params)+fixtureExt), append(data, '\n'), 0o600)
+}
+
+// decodeMessages decodes either the single json-rpc message or the batch of them
+func decodeMessages(data []byte) (messages []*rpcMessage, batch bool, err error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		err = json.Unmarshal(data, &messages)
+		return messages, true, err
+	}
+
+	message := new(rpcMessage)
+	if err = json.Unmarshal(data, message); err != nil {
+		return nil, false, err
+	}
+	return []*rpcMessage{message}, false, nil
+}
@@ -0,0 +1,124 @@
+package fixture
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+type FixtureTestSuite struct {
+	suite.Suite
+	ctx  context.Context
+	dir  string
+	node *httptest.Server
+}
+
+// this function executes before each test case
+func (s *FixtureTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.dir = s.T().TempDir()
+
+	// the real node knows only the block number and the balance of a single address
+	s.node = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests, batch, err := decodeMessages(mustRead(r))
+		s.Require().NoError(err)
+
+		responses := make([]*rpcMessage, 0, len(requests))
+		for _, request := range requests {
+			response := &rpcMessage{JSONRPC: "2.0", ID: request.ID}
+			switch request.Method {
+			case "eth_blockNumber":
+				response.Result = json.RawMessage(`"0x5707b1"`)
+			case "eth_getBalance":
+				response.Result = json.RawMessage(`"0x1f4"`)
+			default:
+				response.Error = &Error{Code: -32601, Message: "the method " + request.Method + " does not exist"}
+			}
+			responses = append(responses, response)
+		}
+
+		if batch {
+			_ = json.NewEncoder(w).Encode(responses)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(responses[0])
+	}))
+}
+
+// this function executes after each test case
+func (s *FixtureTestSuite) TearDownTest() {
+	s.node.Close()
+}
+
+func (s *FixtureTestSuite) TestRecordAndReplay() {
+	r := s.Require()
+
+	recorder, err := NewRecorder(s.dir, nil)
+	r.NoError(err)
+	recording, err := rpc.DialOptions(s.ctx, s.node.URL, rpc.WithHTTPClient(&http.Client{Transport: recorder}))
+	r.NoError(err)
+	defer recording.Close()
+
+	var head string
+	r.NoError(recording.CallContext(s.ctx, &head, "eth_blockNumber"))
+	balances := []rpc.BatchElem{
+		{Method: "eth_getBalance", Args: []any{"0xaa449e0226b45d2044b1f721d04001fde02abb08", "latest"}, Result: new(string)},
+		{Method: "eth_chainId", Result: new(string)},
+	}
+	r.NoError(recording.BatchCallContext(s.ctx, balances))
+	r.Error(balances[1].Error, "the node error must be passed to the client")
+
+	calls, err := Load(s.dir)
+	r.NoError(err)
+	r.Len(calls, 3, "each call must be recorded, along with the node errors")
+
+	server, err := NewServer(s.dir)
+	r.NoError(err)
+	defer server.Close()
+	replaying, err := rpc.DialContext(s.ctx, server.URL)
+	r.NoError(err)
+	defer replaying.Close()
+
+	var replayedHead string
+	r.NoError(replaying.CallContext(s.ctx, &replayedHead, "eth_blockNumber"))
+	r.Equal(head, replayedHead)
+
+	replayed := []rpc.BatchElem{
+		{Method: "eth_getBalance", Args: []any{"0xaa449e0226b45d2044b1f721d04001fde02abb08", "latest"}, Result: new(string)},
+		{Method: "eth_chainId", Result: new(string)},
+		{Method: "eth_getBalance", Args: []any{"0x4c16d8c078ef6b56700c1be19a336915962df072", "latest"}, Result: new(string)},
+	}
+	r.NoError(replaying.BatchCallContext(s.ctx, replayed))
+	r.NoError(replayed[0].Error)
+	r.Equal(*balances[0].Result.(*string), *replayed[0].Result.(*string))
+	r.ErrorContains(replayed[1].Error, "does not exist", "the recorded node error must be replayed")
+	r.ErrorContains(replayed[2].Error, "no fixture recorded", "the call without fixture must fail")
+
+	r.Equal(1, server.Served("eth_blockNumber"))
+	r.Equal(1, server.Served("eth_getBalance"))
+}
+
+func (s *FixtureTestSuite) TestCallKey() {
+	r := s.Require()
+
+	r.Equal(callKey("eth_getBalance", json.RawMessage(`["0xaa", "latest"]`)),
+		callKey("eth_getBalance", json.RawMessage(`["0xaa","latest"]`)), "the formatting of params must not matter")
+	r.Equal(callKey("eth_blockNumber", nil), callKey("eth_blockNumber", json.RawMessage(`[]`)))
+	r.NotEqual(callKey("eth_getBalance", json.RawMessage(`["0xaa"]`)),
+		callKey("eth_getBalance", json.RawMessage(`["0xbb"]`)))
+}
+
+func mustRead(r *http.Request) []byte {
+	var raw json.RawMessage
+	_ = json.NewDecoder(r.Body).Decode(&raw)
+	return raw
+}
+
+func TestFixtureTestSuite(t *testing.T) {
+	suite.Run(t, new(FixtureTestSuite))
+}
@@ -0,0 +1,103 @@
+package fixture
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Recorder is the http transport of the node client, that passes the json-rpc calls to the real node
+// and records each of them, along with its response, into the fixture directory
+type Recorder struct {
+	mu   sync.Mutex
+	dir  string
+	next http.RoundTripper
+}
+
+// NewRecorder creates the recorder of the fixtures into the directory, the calls are passed to the next transport
+// or to the default one, when it is nil
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("cannot create fixture directory: %w", err)
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+// RoundTrip passes the request to the node and records the calls answered by it; failing to record a call
+// does not fail the request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		// only the calls answered by the node are recorded, the transport failures are not replayable
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if err = r.record(reqBody, respBody); err != nil {
+		log.Warnf("cannot record node fixture: %v", err)
+	}
+
+	return resp, nil
+}
+
+// record pairs the requested calls with their responses by ID and saves them
+func (r *Recorder) record(reqBody, respBody []byte) error {
+	requests, _, err := decodeMessages(reqBody)
+	if err != nil {
+		return err
+	}
+	responses, _, err := decodeMessages(respBody)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*rpcMessage, len(responses))
+	for _, response := range responses {
+		byID[string(response.ID)] = response
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, request := range requests {
+		response, found := byID[string(request.ID)]
+		if !found {
+			continue
+		}
+
+		call := &Call{Method: request.Method, Params: request.Params, Result: response.Result, Error: response.Error}
+		if call.Result == nil && call.Error == nil {
+			// the omitted result stands for null, e.g. unknown transaction
+			call.Result = []byte("null")
+		}
+		if err = save(r.dir, call); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
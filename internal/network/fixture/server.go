@@ -0,0 +1,93 @@
+package fixture
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// errCodeNoFixture is the json-rpc error code of the calls without recorded fixture
+const errCodeNoFixture = -32601
+
+// Server is the in-process fake json-rpc node, that replays the recorded fixtures; the calls without
+// fixture fail with json-rpc error, so the node client sees them as node errors
+type Server struct {
+	*httptest.Server
+	calls map[string]*Call
+
+	mu     sync.Mutex
+	served map[string]int
+}
+
+// NewServer loads the fixtures of the directory and starts replaying them at the URL of the server
+func NewServer(dir string) (*Server, error) {
+	calls, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		calls:  calls,
+		served: make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s, nil
+}
+
+// Served returns how many times the calls of the method were replayed
+func (s *Server) Served(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.served[method]
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	requests, batch, err := decodeMessages(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	responses := make([]*rpcMessage, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, s.replay(request))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if batch {
+		_ = json.NewEncoder(w).Encode(responses)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(responses[0])
+}
+
+// replay answers the call with its recorded result or error
+func (s *Server) replay(request *rpcMessage) *rpcMessage {
+	response := &rpcMessage{JSONRPC: "2.0", ID: request.ID}
+
+	call, found := s.calls[callKey(request.Method, request.Params)]
+	if !found {
+		response.Error = &Error{
+			Code:    errCodeNoFixture,
+			Message: "no fixture recorded for " + request.Method + " " + string(request.Params),
+		}
+		return response
+	}
+
+	s.mu.Lock()
+	s.served[request.Method]++
+	s.mu.Unlock()
+
+	response.Result, response.Error = call.Result, call.Error
+	if response.Result == nil && response.Error == nil {
+		response.Result = []byte("null")
+	}
+	return response
+}
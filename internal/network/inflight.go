@@ -0,0 +1,179 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ericlagergren/decimal"
+)
+
+// flight is a single fetch of a transaction hash, shared between all the callers asking for it
+type flight struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	refs   int
+	res    TxResult
+}
+
+// ScheduleTask returns a channel that delivers the transaction for the provided hash; concurrent
+// requests for the same hash are attached to the fetch already in progress, instead of scheduling
+// it again, while the cancellation of each caller context affects only that caller
+func (n *EthNode) ScheduleTask(muxCtx context.Context, txHash string) (<-chan TxResult, error) {
+	resChan := make(chan TxResult, 1)
+
+	if muxCtx.Err() != nil {
+		close(resChan)
+		return resChan, fmt.Errorf("request canceled, error fetching info for hash '%s'", txHash)
+	}
+
+	f := n.joinFlight(strings.ToLower(txHash))
+
+	go func() {
+		defer close(resChan)
+
+		select {
+		case <-f.done:
+			resChan <- f.result()
+		case <-muxCtx.Done():
+			n.leaveFlight(strings.ToLower(txHash), f)
+			resChan <- TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: txHash}}, Err: fmt.Errorf("task canceled")}
+		}
+	}()
+
+	return resChan, nil
+}
+
+// joinFlight attaches the caller to the fetch in progress for that hash or starts a new one
+func (n *EthNode) joinFlight(txHash string) *flight {
+	n.flightsMu.Lock()
+	defer n.flightsMu.Unlock()
+
+	if f, found := n.flights[txHash]; found {
+		f.refs++
+		return f
+	}
+
+	// the fetch outlives the first caller, so it is bound only to the app context
+	ctx, cancel := context.WithCancel(n.ctx)
+	f := &flight{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		refs:   1,
+	}
+	n.flights[txHash] = f
+
+	go n.fly(txHash, f)
+
+	return f
+}
+
+// leaveFlight detaches the caller and cancels the fetch when nobody is waiting for it anymore
+func (n *EthNode) leaveFlight(txHash string, f *flight) {
+	n.flightsMu.Lock()
+	defer n.flightsMu.Unlock()
+
+	f.refs--
+	if f.refs > 0 {
+		return
+	}
+
+	f.cancel()
+	if n.flights[txHash] == f {
+		delete(n.flights, txHash)
+	}
+}
+
+// fly hands the task over to the workers and publishes its result to all the attached callers
+func (n *EthNode) fly(txHash string, f *flight) {
+	taskChan := make(chan TxResult, 1)
+	task := TxTask{
+		TxHash:  txHash,
+		Ctx:     f.ctx,
+		ResChan: taskChan,
+	}
+
+	res := TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: txHash}}, Err: fmt.Errorf("task canceled")}
+
+	select {
+	case n.tasksChan <- task:
+		if taskRes, ok := <-taskChan; ok {
+			res = taskRes
+		}
+	case <-f.ctx.Done():
+	}
+
+	n.flightsMu.Lock()
+	// later requests must fetch the hash again (or find it in the database)
+	if n.flights[txHash] == f {
+		delete(n.flights, txHash)
+	}
+	n.flightsMu.Unlock()
+
+	f.res = res
+	f.cancel()
+	close(f.done)
+}
+
+// result gives each caller its own deep copy of the fetched record, so they can store or modify it independently
+func (f *flight) result() TxResult {
+	res := f.res
+	if res.Tx != nil && res.Tx.Transaction != nil {
+		res.Tx = copyRecord(res.Tx)
+	}
+	return res
+}
+
+// copyRecord deep copies the transaction along with its block, logs, transfers and traces; the nil lists stay nil
+// and the empty ones stay empty, e.g. the empty traces still drop the stored ones
+func copyRecord(record *store.TxRecord) *store.TxRecord {
+	tx := *record.Transaction
+	tx.R = nil
+	tx.BlockNumber.Big = copyBig(tx.BlockNumber.Big)
+	tx.AccessList.JSON = bytes.Clone(tx.AccessList.JSON)
+
+	copied := &store.TxRecord{
+		Transaction: &tx,
+		Logs: copyList(record.Logs, func(txLog *models.TransactionLog) {
+			txLog.R = nil
+			txLog.Topics = slices.Clone(txLog.Topics)
+		}),
+		Transfers: copyList(record.Transfers, func(transfer *models.TokenTransfer) { transfer.R = nil }),
+		Traces:    copyList(record.Traces, func(trace *models.TransactionTrace) { trace.R = nil }),
+	}
+	if record.Block != nil {
+		block := *record.Block
+		block.R = nil
+		block.BlockNumber.Big = copyBig(block.BlockNumber.Big)
+		copied.Block = &block
+	}
+	return copied
+}
+
+// copyList copies each element of the list, detach drops what the copy must not share with the original
+func copyList[T any](list []*T, detach func(element *T)) []*T {
+	if list == nil {
+		return nil
+	}
+	copied := make([]*T, 0, len(list))
+	for _, element := range list {
+		elementCopy := *element
+		detach(&elementCopy)
+		copied = append(copied, &elementCopy)
+	}
+	return copied
+}
+
+func copyBig(value *decimal.Big) *decimal.Big {
+	if value == nil {
+		return nil
+	}
+	return new(decimal.Big).Copy(value)
+}
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// InFlightTestSuite proves that concurrent requests for the same hash share a single task,
+// while the test itself plays the role of the workers pool
+type InFlightTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+	node   *EthNode
+}
+
+// this function executes before the test suite begins execution
+func (s *InFlightTestSuite) SetupSuite() {
+	cmd.LogInit("fatal")
+}
+
+// this function executes before each test case
+func (s *InFlightTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.node = &EthNode{
+		ctx:       s.ctx,
+		tasksChan: make(chan TxTask),
+		flights:   make(map[string]*flight),
+	}
+}
+
+// this function executes after each test case
+func (s *InFlightTestSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *InFlightTestSuite) TestConcurrentCallersShareTask() {
+	r := s.Require()
+	hash := "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111"
+
+	first, err := s.node.ScheduleTask(s.ctx, hash)
+	r.NoError(err)
+	task := s.receiveTask()
+
+	second, err := s.node.ScheduleTask(s.ctx, hash)
+	r.NoError(err)
+
+	select {
+	case <-s.node.tasksChan:
+		s.FailNow("the same hash must not be scheduled twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	task.ResChan <- TxResult{Tx: &store.TxRecord{
+		Transaction: &models.Transaction{TXHash: hash, LogsCount: 3},
+		Logs:        []*models.TransactionLog{{TXHash: hash, Topics: []string{"0xddf252ad"}}},
+		Traces:      []*models.TransactionTrace{},
+	}}
+	close(task.ResChan)
+
+	res1, res2 := <-first, <-second
+	r.NoError(res1.Err)
+	r.NoError(res2.Err)
+	r.Equal(int64(3), res1.Tx.LogsCount)
+	r.Equal(int64(3), res2.Tx.LogsCount)
+	r.NotSame(res1.Tx, res2.Tx, "each caller must own its copy of the transaction")
+
+	// the lists are copied along with their elements, keeping the nil ones nil and the empty ones empty
+	res1.Tx.Logs[0].Topics[0] = "0x8c5be1e5"
+	res1.Tx.Logs = append(res1.Tx.Logs, &models.TransactionLog{TXHash: hash})
+	r.Equal([]string{"0xddf252ad"}, []string(res2.Tx.Logs[0].Topics), "each caller must own its copy of the logs")
+	r.Len(res2.Tx.Logs, 1)
+	r.Nil(res2.Tx.Transfers)
+	r.NotNil(res2.Tx.Traces)
+	r.Empty(res2.Tx.Traces)
+
+	// once completed, the hash can be scheduled again
+	_, err = s.node.ScheduleTask(s.ctx, hash)
+	r.NoError(err)
+	s.receiveTask()
+}
+
+func (s *InFlightTestSuite) TestCallerCancellationIsSeparate() {
+	r := s.Require()
+	hash := "0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222"
+
+	leavingCtx, leave := context.WithCancel(s.ctx)
+	leaving, err := s.node.ScheduleTask(leavingCtx, hash)
+	r.NoError(err)
+	task := s.receiveTask()
+
+	staying, err := s.node.ScheduleTask(s.ctx, hash)
+	r.NoError(err)
+
+	// the first caller goes away, but the task must continue for the second one
+	leave()
+	r.Error((<-leaving).Err)
+	r.NoError(task.Ctx.Err())
+
+	task.ResChan <- TxResult{Tx: &store.TxRecord{Transaction: &models.Transaction{TXHash: hash}}}
+	close(task.ResChan)
+	r.NoError((<-staying).Err)
+}
+
+func (s *InFlightTestSuite) TestLastCallerCancelsTask() {
+	r := s.Require()
+	hash := "0x33333f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df73333"
+
+	callerCtx, cancel := context.WithCancel(s.ctx)
+	res, err := s.node.ScheduleTask(callerCtx, hash)
+	r.NoError(err)
+	task := s.receiveTask()
+
+	cancel()
+	r.Error((<-res).Err)
+
+	select {
+	case <-task.Ctx.Done():
+	case <-time.After(time.Second):
+		s.FailNow("the task must be canceled when no caller waits for it")
+	}
+
+	_, err = s.node.ScheduleTask(callerCtx, hash)
+	r.Error(err, "canceled caller cannot schedule tasks")
+}
+
+func (s *InFlightTestSuite) receiveTask() TxTask {
+	select {
+	case task := <-s.node.tasksChan:
+		return task
+	case <-time.After(time.Second):
+		s.FailNow("task was not scheduled")
+	}
+	return TxTask{}
+}
+
+func TestInFlightTestSuite(t *testing.T) {
+	suite.Run(t, new(InFlightTestSuite))
+}
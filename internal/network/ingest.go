@@ -0,0 +1,57 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// json-rpc method used to subscribe to the new heads of the chain
+const methodSubscribe = "eth_subscribe"
+
+// ErrSubscriptionUnsupported is returned when the node does not push the new heads, e.g. it is connected over http
+var ErrSubscriptionUnsupported = errors.New("subscription not supported")
+
+// Subscription is the live subscription to the node, e.g. to the new heads of the chain
+type Subscription = ethereum.Subscription
+
+// SubscribeNewHeads delivers the numbers of the new heads of the chain, pushed by the node connected over
+// websocket, as long as the subscription lasts; the nodes connected over http fail with ErrSubscriptionUnsupported
+func (n *EthNode) SubscribeNewHeads(ctx context.Context, heads chan<- uint64) (Subscription, error) {
+	headers := make(chan *types.Header)
+	var sub ethereum.Subscription
+	err := n.callNode(ctx, methodSubscribe, func(client *ethclient.Client) error {
+		var err error
+		sub, err = client.SubscribeNewHead(ctx, headers)
+		return err
+	})
+	if isMethodUnsupported(err) {
+		return nil, fmt.Errorf("%w: %w", ErrSubscriptionUnsupported, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new heads: %w", err)
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case header := <-headers:
+				select {
+				case heads <- header.Number.Uint64():
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
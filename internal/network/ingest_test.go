@@ -0,0 +1,113 @@
+package network
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ethereum-fetcher/cmd"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+// SubscribeTestSuite proves that the new heads are pushed by the nodes connected over websocket, while the nodes
+// connected over http are reported as not supporting it
+type SubscribeTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// this function executes before the test suite begins execution
+func (s *SubscribeTestSuite) SetupSuite() {
+	cmd.LogInit("fatal")
+}
+
+// this function executes before each test case
+func (s *SubscribeTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+}
+
+// this function executes after each test case
+func (s *SubscribeTestSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *SubscribeTestSuite) TestSubscribeNewHeads() {
+	r := s.Require()
+
+	srv := rpc.NewServer()
+	defer srv.Stop()
+	r.NoError(srv.RegisterName("eth", &newHeadsService{numbers: []uint64{5703601, 5703602}}))
+	ws := httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+	defer ws.Close()
+
+	node := s.newNode("ws" + strings.TrimPrefix(ws.URL, "http"))
+
+	heads := make(chan uint64)
+	sub, err := node.SubscribeNewHeads(s.ctx, heads)
+	r.NoError(err)
+	defer sub.Unsubscribe()
+
+	for _, want := range []uint64{5703601, 5703602} {
+		select {
+		case number := <-heads:
+			r.Equal(want, number)
+		case err = <-sub.Err():
+			r.FailNow("the subscription must not break", err)
+		case <-time.After(5 * time.Second):
+			r.FailNow("the new head must be pushed")
+		}
+	}
+}
+
+func (s *SubscribeTestSuite) TestSubscribeNewHeadsOverHTTP() {
+	r := s.Require()
+
+	srv, _ := newBlockNumberServer()
+	defer srv.Close()
+
+	_, err := s.newNode(srv.URL).SubscribeNewHeads(s.ctx, make(chan uint64))
+	r.ErrorIs(err, ErrSubscriptionUnsupported)
+}
+
+func (s *SubscribeTestSuite) newNode(url string) *EthNode {
+	pool, err := NewNodePool(s.ctx, []string{url}, 1, 0, nil)
+	s.Require().NoError(err)
+
+	return &EthNode{
+		ctx:         s.ctx,
+		pool:        pool,
+		rateLimiter: NewRateLimiter(100, 100, nil),
+	}
+}
+
+func TestSubscribeTestSuite(t *testing.T) {
+	suite.Run(t, new(SubscribeTestSuite))
+}
+
+// newHeadsService pushes the heads of the provided numbers to each eth_subscribe("newHeads") subscriber
+type newHeadsService struct {
+	numbers []uint64
+}
+
+func (h *newHeadsService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, number := range h.numbers {
+			_ = notifier.Notify(sub.ID, &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: common.Big0})
+		}
+	}()
+	return sub, nil
+}
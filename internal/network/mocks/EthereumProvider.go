@@ -4,11 +4,14 @@ package mocks
 
 import (
 	context "context"
-	models "ethereum-fetcher/internal/store/pg/models"
 
-	mock "github.com/stretchr/testify/mock"
+	ethereum "github.com/ethereum/go-ethereum"
 
 	network "ethereum-fetcher/internal/network"
+
+	mock "github.com/stretchr/testify/mock"
+
+	store "ethereum-fetcher/internal/store"
 )
 
 // EthereumProvider is an autogenerated mock type for the EthereumProvider type
@@ -16,24 +19,110 @@ type EthereumProvider struct {
 	mock.Mock
 }
 
+// BlockHashByNumber provides a mock function with given fields: ctx, number
+func (_m *EthereumProvider) BlockHashByNumber(ctx context.Context, number uint64) (string, error) {
+	ret := _m.Called(ctx, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockHashByNumber")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (string, error)); ok {
+		return rf(ctx, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) string); ok {
+		r0 = rf(ctx, number)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockTransactions provides a mock function with given fields: ctx, number
+func (_m *EthereumProvider) BlockTransactions(ctx context.Context, number uint64) ([]*store.TxRecord, error) {
+	ret := _m.Called(ctx, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTransactions")
+	}
+
+	var r0 []*store.TxRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]*store.TxRecord, error)); ok {
+		return rf(ctx, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*store.TxRecord); ok {
+		r0 = rf(ctx, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*store.TxRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinalizedBlockNumber provides a mock function with given fields: ctx
+func (_m *EthereumProvider) FinalizedBlockNumber(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizedBlockNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionByHash provides a mock function with given fields: task
-func (_m *EthereumProvider) GetTransactionByHash(task network.TxTask) (*models.Transaction, error) {
+func (_m *EthereumProvider) GetTransactionByHash(task network.TxTask) (*store.TxRecord, error) {
 	ret := _m.Called(task)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionByHash")
 	}
 
-	var r0 *models.Transaction
+	var r0 *store.TxRecord
 	var r1 error
-	if rf, ok := ret.Get(0).(func(network.TxTask) (*models.Transaction, error)); ok {
+	if rf, ok := ret.Get(0).(func(network.TxTask) (*store.TxRecord, error)); ok {
 		return rf(task)
 	}
-	if rf, ok := ret.Get(0).(func(network.TxTask) *models.Transaction); ok {
+	if rf, ok := ret.Get(0).(func(network.TxTask) *store.TxRecord); ok {
 		r0 = rf(task)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Transaction)
+			r0 = ret.Get(0).(*store.TxRecord)
 		}
 	}
 
@@ -46,6 +135,34 @@ func (_m *EthereumProvider) GetTransactionByHash(task network.TxTask) (*models.T
 	return r0, r1
 }
 
+// HeadBlockNumber provides a mock function with given fields: ctx
+func (_m *EthereumProvider) HeadBlockNumber(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeadBlockNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleTask provides a mock function with given fields: muxCtx, txHash
 func (_m *EthereumProvider) ScheduleTask(muxCtx context.Context, txHash string) (<-chan network.TxResult, error) {
 	ret := _m.Called(muxCtx, txHash)
@@ -76,6 +193,66 @@ func (_m *EthereumProvider) ScheduleTask(muxCtx context.Context, txHash string)
 	return r0, r1
 }
 
+// SendRawTransaction provides a mock function with given fields: ctx, rawTx
+func (_m *EthereumProvider) SendRawTransaction(ctx context.Context, rawTx []byte) (*store.TxRecord, error) {
+	ret := _m.Called(ctx, rawTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRawTransaction")
+	}
+
+	var r0 *store.TxRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*store.TxRecord, error)); ok {
+		return rf(ctx, rawTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *store.TxRecord); ok {
+		r0 = rf(ctx, rawTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.TxRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, rawTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeNewHeads provides a mock function with given fields: ctx, heads
+func (_m *EthereumProvider) SubscribeNewHeads(ctx context.Context, heads chan<- uint64) (ethereum.Subscription, error) {
+	ret := _m.Called(ctx, heads)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeNewHeads")
+	}
+
+	var r0 ethereum.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- uint64) (ethereum.Subscription, error)); ok {
+		return rf(ctx, heads)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- uint64) ethereum.Subscription); ok {
+		r0 = rf(ctx, heads)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ethereum.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, chan<- uint64) error); ok {
+		r1 = rf(ctx, heads)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewEthereumProvider creates a new instance of EthereumProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEthereumProvider(t interface {
@@ -3,18 +3,20 @@ package network
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
 	"strings"
 	"sync"
-	"time"
 
+	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg/models"
 
 	"github.com/ericlagergren/decimal"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	log "github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
 	boilTypes "github.com/volatiletech/sqlboiler/v4/types"
@@ -28,12 +30,14 @@ type TxTask struct {
 
 // TxResult is used to transport fetch results over channels
 type TxResult struct {
-	Tx  *models.Transaction
+	Tx  *store.TxRecord
 	Err error
+	// Siblings are the other transactions of the same block, pre-warmed along with the transaction
+	Siblings []*store.TxRecord
 }
 
 // GetTransactionByHash fetch the transaction from the node by provided hash
-func (n *EthNode) GetTransactionByHash(task TxTask) (*models.Transaction, error) {
+func (n *EthNode) GetTransactionByHash(task TxTask) (*store.TxRecord, error) {
 	var wg sync.WaitGroup
 
 	txHash := common.HexToHash(task.TxHash)
@@ -68,66 +72,54 @@ func (n *EthNode) GetTransactionByHash(task TxTask) (*models.Transaction, error)
 		return nil, errors.New("fetching ethereum tx data got interrupted by context cancellation")
 	}
 
-	if len(errList) > 0 {
-		// sort the errors list, for consistency, since both goroutines might return in random order
-		slices.SortFunc(errList, func(a, b error) int {
-			return strings.Compare(a.Error(), b.Error())
-		})
-		return nil, errors.Join(errList...)
+	// the transaction is known, but still waits in the mempool to be mined
+	if len(errList) == 1 && ethTX != nil && IsNotFound(errList[0]) {
+		return n.newTransaction(ethTX, nil)
 	}
 
-	var toAddress null.String
-	if addr := ethTX.To(); addr != nil && *addr != (common.Address{}) {
-		toAddress = null.StringFrom(ethTX.To().Hex())
+	if len(errList) > 0 {
+		return nil, joinErrors(errList)
 	}
 
-	var contractAddress null.String
-	if receipt.ContractAddress != (common.Address{}) {
-		contractAddress = null.StringFrom(receipt.ContractAddress.Hex())
-	}
-	bigDec := new(decimal.Big)
-	bigDec.SetBigMantScale(receipt.BlockNumber, 0)
+	return n.minedRecord(task.Ctx, ethTX, receipt)
+}
 
-	fromAddress, err := getTransactionSender(ethTX)
+// minedRecord combines the mined transaction and its receipt into its record, along with its block and traces
+func (n *EthNode) minedRecord(ctx context.Context, ethTX *types.Transaction, receipt *types.Receipt) (
+	*store.TxRecord, error) {
+	record, err := n.newTransaction(ethTX, receipt)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching ethereum tx data: %v", err)
+		return nil, err
 	}
-
-	tx := &models.Transaction{
-		TXHash: ethTX.Hash().Hex(),
-		// nolint:gosec // handles only the status of the transaction either 1 (success) or 0 (failure)
-		TXStatus:        int(receipt.Status),
-		BlockHash:       receipt.BlockHash.Hex(),
-		BlockNumber:     boilTypes.NewDecimal(bigDec),
-		FromAddress:     fromAddress,
-		ToAddress:       toAddress,
-		ContractAddress: contractAddress,
-		LogsCount:       int64(len(receipt.Logs)),
-		Input:           fmt.Sprintf("0x%s", hex.EncodeToString(ethTX.Data())),
-		Value:           ethTX.Value().String(),
-	}
-
-	return tx, nil
+	if err = n.verifyReceipt(ctx, record, ethTX, receipt); err != nil {
+		return nil, err
+	}
+	// the transactions of the same block share the header lookup
+	if err = n.attachBlock(ctx, record); err != nil {
+		return nil, err
+	}
+	// the traces are fetched one by one, since the tracing is far more expensive than the batched calls
+	if err = n.attachTraces(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
 }
 
 func (n *EthNode) fetchTransactionReceipt(ctx context.Context, wg *sync.WaitGroup, receipt **types.Receipt,
 	txHash common.Hash, errCh chan error) {
 	go func() {
 		defer wg.Done()
-		// fetch the transaction receipt
-		for {
-			if n.rateLimiter.Allow() {
-				var err error
-				*receipt, err = n.client.TransactionReceipt(ctx, txHash)
-				if err != nil {
-					select {
-					case errCh <- fmt.Errorf("failed to fetch transaction receipt: %v", err):
-					case <-ctx.Done():
-					}
-				}
-				return
+		// fetch the transaction receipt, but obey the rate limitations of the node
+		err := n.callNode(ctx, methodTransactionReceipt, func(client *ethclient.Client) error {
+			var err error
+			*receipt, err = client.TransactionReceipt(ctx, txHash)
+			return err
+		})
+		if err != nil {
+			select {
+			case errCh <- fmt.Errorf("failed to fetch transaction receipt: %w", err):
+			case <-ctx.Done():
 			}
-			time.Sleep(n.rateLimiter.WaitDuration())
 		}
 	}()
 }
@@ -137,40 +129,143 @@ func (n *EthNode) fetchTransactionDetails(ctx context.Context, wg *sync.WaitGrou
 	go func() {
 		defer wg.Done()
 		// fetch a transaction by its hash, but obey the rate limitations of the node
-		for {
-			if n.rateLimiter.Allow() {
-				var err error
-				*ethTX, _, err = n.client.TransactionByHash(ctx, txHash)
-				if err != nil {
-					select {
-					case errCh <- fmt.Errorf("failed to fetch transaction details: %v", err):
-					case <-ctx.Done():
-					}
-				}
-				return
+		err := n.callNode(ctx, methodTransactionByHash, func(client *ethclient.Client) error {
+			var err error
+			*ethTX, _, err = client.TransactionByHash(ctx, txHash)
+			return err
+		})
+		if err != nil {
+			select {
+			case errCh <- fmt.Errorf("failed to fetch transaction details: %w", err):
+			case <-ctx.Done():
 			}
-			time.Sleep(n.rateLimiter.WaitDuration())
 		}
 	}()
 }
 
-func (n *EthNode) ScheduleTask(muxCtx context.Context, txHash string) (<-chan TxResult, error) {
-	resChan := make(chan TxResult)
+// newTransaction combines the transaction details and its receipt into the stored model; without receipt
+// the transaction is stored as pending, with no block, status, logs and token transfers
+func (n *EthNode) newTransaction(ethTX *types.Transaction, receipt *types.Receipt) (*store.TxRecord, error) {
+	var toAddress null.String
+	if addr := ethTX.To(); addr != nil && *addr != (common.Address{}) {
+		toAddress = null.StringFrom(ethTX.To().Hex())
+	}
+
+	fromAddress, err := getTransactionSender(ethTX)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ethereum tx data: %v", err)
+	}
+
+	tx := &models.Transaction{
+		ChainID:     n.chainID,
+		TXHash:      ethTX.Hash().Hex(),
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Input:       fmt.Sprintf("0x%s", hex.EncodeToString(ethTX.Data())),
+		Value:       ethTX.Value().String(),
+		Pending:     receipt == nil,
+	}
+	if err = setTransactionFees(tx, ethTX); err != nil {
+		return nil, fmt.Errorf("error fetching ethereum tx data: %v", err)
+	}
+
+	if receipt == nil {
+		return &store.TxRecord{Transaction: tx}, nil
+	}
+
+	if receipt.ContractAddress != (common.Address{}) {
+		tx.ContractAddress = null.StringFrom(receipt.ContractAddress.Hex())
+	}
+	bigDec := new(decimal.Big)
+	bigDec.SetBigMantScale(receipt.BlockNumber, 0)
+
+	// nolint:gosec // handles only the status of the transaction either 1 (success) or 0 (failure)
+	tx.TXStatus = null.IntFrom(int(receipt.Status))
+	tx.BlockHash = null.StringFrom(receipt.BlockHash.Hex())
+	tx.BlockNumber = boilTypes.NewNullDecimal(bigDec)
+	tx.LogsCount = int64(len(receipt.Logs))
+	setReceiptFees(tx, receipt)
+
+	return &store.TxRecord{
+		Transaction: tx,
+		Logs:        n.newTransactionLogs(tx.TXHash, receipt.Logs),
+		Transfers:   n.newTokenTransfers(tx.TXHash, receipt.Logs),
+	}, nil
+}
+
+// setTransactionFees sets the type, the nonce and the gas fields of the transaction, the fee caps are
+// set only for the types that have them
+func setTransactionFees(tx *models.Transaction, ethTX *types.Transaction) error {
+	// nolint:gosec // the nonce is far below the max int64
+	tx.Nonce = null.Int64From(int64(ethTX.Nonce()))
+	tx.TXType = null.IntFrom(int(ethTX.Type()))
+	// nolint:gosec // the gas limit is far below the max int64
+	tx.GasLimit = null.Int64From(int64(ethTX.Gas()))
+	tx.GasPrice = null.StringFrom(ethTX.GasPrice().String())
 
-	task := TxTask{
-		TxHash:  txHash,
-		Ctx:     muxCtx,
-		ResChan: resChan,
+	if ethTX.Type() >= types.DynamicFeeTxType {
+		tx.MaxFeePerGas = null.StringFrom(ethTX.GasFeeCap().String())
+		tx.MaxPriorityFeePerGas = null.StringFrom(ethTX.GasTipCap().String())
+	}
+	if ethTX.Type() == types.BlobTxType {
+		tx.MaxFeePerBlobGas = null.StringFrom(ethTX.BlobGasFeeCap().String())
+	}
+	if ethTX.Type() != types.LegacyTxType {
+		accessList, err := json.Marshal(ethTX.AccessList())
+		if err != nil {
+			return err
+		}
+		tx.AccessList = null.JSONFrom(accessList)
 	}
 
-	select {
-	case n.tasksChan <- task:
-	case <-muxCtx.Done():
-		close(resChan)
-		return resChan, fmt.Errorf("request canceled, error fetching info for hash '%s'", txHash)
+	return nil
+}
+
+// setReceiptFees sets the gas spent by the mined transaction
+func setReceiptFees(tx *models.Transaction, receipt *types.Receipt) {
+	// nolint:gosec // the gas used is far below the max int64
+	tx.GasUsed = null.Int64From(int64(receipt.GasUsed))
+	if receipt.EffectiveGasPrice != nil {
+		tx.EffectiveGasPrice = null.StringFrom(receipt.EffectiveGasPrice.String())
+	}
+	if receipt.BlobGasUsed > 0 {
+		// nolint:gosec // the blob gas used is far below the max int64
+		tx.BlobGasUsed = null.Int64From(int64(receipt.BlobGasUsed))
+		if receipt.BlobGasPrice != nil {
+			tx.BlobGasPrice = null.StringFrom(receipt.BlobGasPrice.String())
+		}
 	}
+}
+
+// newTransactionLogs converts the receipt logs into the stored model
+func (n *EthNode) newTransactionLogs(txHash string, logs []*types.Log) []*models.TransactionLog {
+	txLogs := make([]*models.TransactionLog, 0, len(logs))
+	for _, l := range logs {
+		topics := make(boilTypes.StringArray, 0, len(l.Topics))
+		for _, topic := range l.Topics {
+			topics = append(topics, topic.Hex())
+		}
+
+		txLogs = append(txLogs, &models.TransactionLog{
+			ChainID:  n.chainID,
+			TXHash:   txHash,
+			LogIndex: int(l.Index),
+			Address:  l.Address.Hex(),
+			Topics:   topics,
+			Data:     fmt.Sprintf("0x%s", hex.EncodeToString(l.Data)),
+			Removed:  l.Removed,
+		})
+	}
+	return txLogs
+}
 
-	return resChan, nil
+// joinErrors handles multiple errors as one, sorted for consistency, since the fetches might
+// return in random order
+func joinErrors(errList []error) error {
+	slices.SortFunc(errList, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+	return errors.Join(errList...)
 }
 
 // getTransactionSender function to get the sender address
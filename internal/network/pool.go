@@ -0,0 +1,280 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// healthAlpha is the weight of the latest observation in the moving averages of latency and error rate
+	healthAlpha = 0.2
+	// errRatePenalty multiplies the latency of an endpoint by up to that factor when all of its calls fail
+	errRatePenalty = 10
+	// probeTimeout limits the time spent on probing a single ejected endpoint
+	probeTimeout = 5 * time.Second
+)
+
+// ErrNoNodeAvailable is returned when none of the configured ethereum nodes could serve the call
+var ErrNoNodeAvailable = errors.New("no ethereum node available")
+
+// endpoint wraps a single ethereum node connection along with its health statistics
+type endpoint struct {
+	mu       sync.Mutex
+	url      string
+	priority int
+	client   *ethclient.Client
+	latency  time.Duration
+	errRate  float64
+	failures int
+	ejected  bool
+}
+
+// NodePool routes the node calls to the healthiest endpoint and fails over to the next one on error
+type NodePool struct {
+	ctx           context.Context
+	endpoints     []*endpoint
+	ejectAfter    int
+	probeInterval time.Duration
+	transport     http.RoundTripper
+}
+
+// NewNodePool dials all provided urls (in order of preference) and starts the background probing
+// of the ejected endpoints; endpoints that cannot be dialed are ejected from the very beginning.
+// The http calls go through the transport, when provided, e.g. to record them
+func NewNodePool(ctx context.Context, urls []string, ejectAfter int, probeInterval time.Duration,
+	transport http.RoundTripper) (*NodePool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("at least one ethereum node url must be provided")
+	}
+
+	pool := &NodePool{
+		ctx:           ctx,
+		endpoints:     make([]*endpoint, 0, len(urls)),
+		ejectAfter:    max(ejectAfter, 1),
+		probeInterval: probeInterval,
+		transport:     transport,
+	}
+
+	for i, url := range urls {
+		ep := &endpoint{url: url, priority: i}
+		client, err := pool.dial(ctx, url)
+		if err != nil {
+			log.Warnf("cannot connect to ethereum node #%d, it will be probed later: %v", i, err)
+			ep.ejected = true
+		}
+		ep.client = client
+		pool.endpoints = append(pool.endpoints, ep)
+	}
+
+	if probeInterval > 0 {
+		go pool.probeLoop()
+	}
+
+	return pool, nil
+}
+
+// dial connects the client of the node, through the transport of the pool, when provided
+func (p *NodePool) dial(ctx context.Context, url string) (*ethclient.Client, error) {
+	if p.transport == nil {
+		return ethclient.DialContext(ctx, url)
+	}
+
+	client, err := rpc.DialOptions(ctx, url, rpc.WithHTTPClient(&http.Client{Transport: p.transport}))
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(client), nil
+}
+
+// ParseNodeURLs splits comma separated list of node urls, ignoring the empty entries
+func ParseNodeURLs(list string) []string {
+	var urls []string
+	for _, url := range strings.Split(list, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// Do executes the call against the healthiest endpoint and transparently fails over to the
+// next one, as long as the error is caused by the node itself
+func (p *NodePool) Do(ctx context.Context, call func(client *ethclient.Client) error) error {
+	var errList []error
+
+	for _, ep := range p.candidates() {
+		start := time.Now()
+		err := call(ep.client)
+		if !isNodeFault(ctx, err) {
+			ep.succeeded(time.Since(start))
+			return err
+		}
+
+		if ep.failed(p.ejectAfter) {
+			log.Warnf("ethereum node #%d got ejected after %d consecutive failures: %v", ep.priority, p.ejectAfter, err)
+		}
+		errList = append(errList, fmt.Errorf("node #%d: %w", ep.priority, err))
+	}
+
+	if len(errList) == 0 {
+		return ErrNoNodeAvailable
+	}
+
+	return errors.Join(errList...)
+}
+
+// candidates returns the healthy endpoints ordered by their score, or all the connected endpoints,
+// when none is healthy, as a last resort
+func (p *NodePool) candidates() []*endpoint {
+	healthy := make([]*endpoint, 0, len(p.endpoints))
+	connected := make([]*endpoint, 0, len(p.endpoints))
+	scores := make(map[*endpoint]float64, len(p.endpoints))
+
+	for _, ep := range p.endpoints {
+		ep.mu.Lock()
+		if ep.client != nil {
+			connected = append(connected, ep)
+			if !ep.ejected {
+				healthy = append(healthy, ep)
+			}
+		}
+		scores[ep] = ep.score()
+		ep.mu.Unlock()
+	}
+
+	list := healthy
+	if len(list) == 0 {
+		list = connected
+	}
+
+	// lower score wins, the configured order of endpoints breaks the ties
+	slices.SortStableFunc(list, func(a, b *endpoint) int {
+		if scores[a] < scores[b] {
+			return -1
+		}
+		if scores[a] > scores[b] {
+			return 1
+		}
+		return a.priority - b.priority
+	})
+
+	return list
+}
+
+// probeLoop periodically re-probes the ejected endpoints, until the app context is done
+func (p *NodePool) probeLoop() {
+	ticker := time.NewTicker(p.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.probe()
+		}
+	}
+}
+
+// probe checks each ejected endpoint and brings it back to rotation when it responds
+func (p *NodePool) probe() {
+	for _, ep := range p.endpoints {
+		ep.mu.Lock()
+		ejected, client := ep.ejected, ep.client
+		ep.mu.Unlock()
+
+		if !ejected {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(p.ctx, probeTimeout)
+		if client == nil {
+			var err error
+			if client, err = p.dial(ctx, ep.url); err != nil {
+				cancel()
+				continue
+			}
+		}
+
+		start := time.Now()
+		_, err := client.BlockNumber(ctx)
+		cancel()
+		if err != nil {
+			continue
+		}
+
+		ep.readmit(client, time.Since(start))
+		log.Infof("ethereum node #%d is healthy again", ep.priority)
+	}
+}
+
+// score combines latency and error rate of the endpoint, lower is better; caller must hold the lock
+func (e *endpoint) score() float64 {
+	return float64(e.latency.Milliseconds()+1) * (1 + errRatePenalty*e.errRate)
+}
+
+func (e *endpoint) succeeded(latency time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures = 0
+	e.errRate *= 1 - healthAlpha
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration((1-healthAlpha)*float64(e.latency) + healthAlpha*float64(latency))
+	}
+}
+
+// failed records a failure and reports whether the endpoint just got ejected
+func (e *endpoint) failed(ejectAfter int) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures++
+	e.errRate = (1-healthAlpha)*e.errRate + healthAlpha
+	if !e.ejected && e.failures >= ejectAfter {
+		e.ejected = true
+		return true
+	}
+	return false
+}
+
+func (e *endpoint) readmit(client *ethclient.Client, latency time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.client = client
+	e.ejected = false
+	e.failures = 0
+	e.errRate = 0
+	e.latency = latency
+}
+
+// isNodeFault reports whether the error is caused by the node, so another one should be tried;
+// missing data, canceled requests and the requests rejected by the node (e.g. nonce too low, reverted call,
+// invalid params) are valid outcomes that the other nodes would not change
+func isNodeFault(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		code := rpcErr.ErrorCode()
+		return code == limitExceededCode || code == internalErrorCode
+	}
+
+	return !errors.Is(err, ethereum.NotFound)
+}
@@ -0,0 +1,182 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ethereum-fetcher/cmd"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/suite"
+)
+
+// NodePoolTestSuite proves that the pool fails over, ejects and re-admits the endpoints,
+// using minimal JSON-RPC servers that can be switched between healthy and broken state
+type NodePoolTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// this function executes before the test suite begins execution
+func (s *NodePoolTestSuite) SetupSuite() {
+	cmd.LogInit("fatal")
+}
+
+// this function executes before each test case
+func (s *NodePoolTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+}
+
+// this function executes after each test case
+func (s *NodePoolTestSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *NodePoolTestSuite) TestFailoverAndEjection() {
+	r := s.Require()
+
+	primary, primaryBroken := newBlockNumberServer()
+	defer primary.Close()
+	backup, backupBroken := newBlockNumberServer()
+	defer backup.Close()
+
+	pool, err := NewNodePool(s.ctx, []string{primary.URL, backup.URL}, 2, 0, nil)
+	r.NoError(err)
+
+	call := func(client *ethclient.Client) error {
+		_, err := client.BlockNumber(s.ctx)
+		return err
+	}
+
+	// the call succeeds through the backup
+	primaryBroken.Store(true)
+	r.NoError(pool.Do(s.ctx, call))
+	r.Len(pool.candidates(), 2, "primary node must not be ejected after a single failure")
+
+	// both nodes fail, so the primary reaches the failures threshold
+	backupBroken.Store(true)
+	r.Error(pool.Do(s.ctx, call))
+	backupBroken.Store(false)
+
+	candidates := pool.candidates()
+	r.Len(candidates, 1, "primary node must be ejected")
+	r.Equal(backup.URL, candidates[0].url)
+
+	// the probe must not bring back the primary while it is still broken
+	pool.probe()
+	r.Len(pool.candidates(), 1)
+
+	primaryBroken.Store(false)
+	pool.probe()
+	r.Len(pool.candidates(), 2, "primary node must be re-admitted")
+}
+
+func (s *NodePoolTestSuite) TestAllNodesDown() {
+	r := s.Require()
+
+	primary, primaryBroken := newBlockNumberServer()
+	defer primary.Close()
+	primaryBroken.Store(true)
+
+	pool, err := NewNodePool(s.ctx, []string{primary.URL}, 1, 0, nil)
+	r.NoError(err)
+
+	call := func(client *ethclient.Client) error {
+		_, err := client.BlockNumber(s.ctx)
+		return err
+	}
+
+	r.Error(pool.Do(s.ctx, call))
+	// ejected endpoints are still used as a last resort
+	r.Error(pool.Do(s.ctx, call))
+
+	primaryBroken.Store(false)
+	r.NoError(pool.Do(s.ctx, call))
+}
+
+func (s *NodePoolTestSuite) TestRejectedRequest() {
+	r := s.Require()
+
+	primary, primaryCalls := newRejectingServer()
+	defer primary.Close()
+	backup, backupCalls := newRejectingServer()
+	defer backup.Close()
+
+	pool, err := NewNodePool(s.ctx, []string{primary.URL, backup.URL}, 1, 0, nil)
+	r.NoError(err)
+
+	call := func(client *ethclient.Client) error {
+		_, err := client.BlockNumber(s.ctx)
+		return err
+	}
+
+	// the request rejected by the node is not a node fault, so it is neither failed over nor counted; either node
+	// may serve it, depending on the latency measured so far
+	for i := 1; i <= 3; i++ {
+		r.ErrorContains(pool.Do(s.ctx, call), "nonce too low")
+		r.Equal(int64(i), primaryCalls.Load()+backupCalls.Load(), "rejected request must not fail over")
+	}
+	for _, ep := range pool.endpoints {
+		ep.mu.Lock()
+		failures, errRate := ep.failures, ep.errRate
+		ep.mu.Unlock()
+		r.Zero(failures, "rejected request must not be counted as failure of node #%d", ep.priority)
+		r.Zero(errRate, "rejected request must not raise the error rate of node #%d", ep.priority)
+	}
+	r.Len(pool.candidates(), 2, "rejected request must not eject the node")
+}
+
+func (s *NodePoolTestSuite) TestParseNodeURLs() {
+	r := s.Require()
+
+	r.Equal([]string{"http://a", "http://b"}, ParseNodeURLs(" http://a, ,http://b,"))
+	r.Empty(ParseNodeURLs(""))
+
+	_, err := NewNodePool(s.ctx, nil, 1, time.Second, nil)
+	r.Error(err)
+}
+
+func TestNodePoolTestSuite(t *testing.T) {
+	suite.Run(t, new(NodePoolTestSuite))
+}
+
+// newBlockNumberServer serves eth_blockNumber or fails with 503, depending on the returned switch
+func newBlockNumberServer() (*httptest.Server, *atomic.Bool) {
+	broken := new(atomic.Bool)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if broken.Load() {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x10"})
+	}))
+	return srv, broken
+}
+
+// newRejectingServer rejects every request with json-rpc error, like a node rejecting a stale nonce, and counts
+// the requests
+func newRejectingServer() (*httptest.Server, *atomic.Int64) {
+	calls := new(atomic.Int64)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID,
+			"error": map[string]any{"code": -32000, "message": "nonce too low"}})
+	}))
+	return srv, calls
+}
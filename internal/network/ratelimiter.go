@@ -2,65 +2,130 @@ package network
 
 import (
 	"context"
+	"expvar"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"ethereum-fetcher/cmd"
 )
 
-// RateLimiter contains the credits channel and the rate at which the credits are supplied
+// json-rpc methods charged against the rate limiter
+const (
+	methodTransactionByHash  = "eth_getTransactionByHash"
+	methodTransactionReceipt = "eth_getTransactionReceipt"
+)
+
+// limiterMetrics exposes the time spent waiting for credits through /debug/vars
+var limiterMetrics = expvar.NewMap("node_rate_limiter")
+
+// RateLimiter is a token bucket, refilled with rate credits per second, up to burst credits
 type RateLimiter struct {
-	ctx     context.Context
-	credits chan struct{}
-	wait    time.Duration
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	costs  map[string]int
 }
 
-// NewRateLimiter creates a new rate limiter with a specified number of credits and refill interval
-func NewRateLimiter(ctx context.Context, maxCredits int, refillInterval time.Duration) *RateLimiter {
-	if maxCredits <= 0 {
-		maxCredits = cmd.DefaultNodeCredit
+// NewRateLimiter creates a new rate limiter that supplies the specified number of credits per second,
+// allows bursts of up to burst credits and charges each json-rpc method with its configured cost
+func NewRateLimiter(ratePerSecond, burst int, costs map[string]int) *RateLimiter {
+	if ratePerSecond <= 0 {
+		ratePerSecond = cmd.DefaultNodeCredit
 	}
-	rl := &RateLimiter{
-		ctx:     ctx,
-		credits: make(chan struct{}, maxCredits),
-		wait:    refillInterval / time.Duration(maxCredits),
+	if burst <= 0 {
+		burst = ratePerSecond
 	}
 
-	// prefill the channel with maxCredits to allow for immediate consumption
-	for i := 0; i < maxCredits; i++ {
-		rl.credits <- struct{}{}
+	// prefill the bucket to allow for immediate consumption
+	return &RateLimiter{
+		rate:   float64(ratePerSecond),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		costs:  costs,
 	}
+}
 
-	// refill the credits periodically
-	go func() {
-		ticker := time.NewTicker(rl.wait)
-		defer ticker.Stop()
-		for range ticker.C {
-			select {
-			case rl.credits <- struct{}{}: // adds credits to the channel if it's not full
-			case <-ctx.Done():
-				return
-			default:
-				// channel is still full, nothing to do
-			}
-		}
-	}()
-
-	return rl
+// Cost returns the configured credits of the json-rpc method, 1 by default
+func (rl *RateLimiter) Cost(method string) int {
+	if cost, found := rl.costs[method]; found {
+		return cost
+	}
+	return 1
 }
 
-// Allow checks credits availability and allow to run if any
-func (rl *RateLimiter) Allow() bool {
+// Wait blocks until the requested credits are available or the context is done; the credits are reserved
+// in the order of the calls, so an expensive call cannot be starved by the cheaper ones
+func (rl *RateLimiter) Wait(ctx context.Context, cost int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	rl.mu.Lock()
+	now := time.Now()
+	rl.refill(now)
+	rl.tokens -= float64(cost)
+	deficit := -rl.tokens
+	rl.mu.Unlock()
+
+	limiterMetrics.Add("charged_credits", int64(cost))
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	wait := time.Duration(deficit / rl.rate * float64(time.Second))
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	limiterMetrics.Add("waits", 1)
+	defer func() {
+		limiterMetrics.AddFloat("wait_seconds", time.Since(now).Seconds())
+	}()
+
 	select {
-	case <-rl.credits: // consume one credit
-		return true
-	case <-rl.ctx.Done():
-		return false
-	default:
-		// no credits available, request should be limited
-		return false
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give back the reservation, for the other callers to use it
+		rl.mu.Lock()
+		rl.refill(time.Now())
+		rl.tokens = min(rl.tokens+float64(cost), rl.burst)
+		rl.mu.Unlock()
+		return ctx.Err()
 	}
 }
 
-func (rl *RateLimiter) WaitDuration() time.Duration {
-	return rl.wait
+// refill adds the credits accumulated since the last refill; caller must hold the lock
+func (rl *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(rl.last).Seconds()
+	rl.last = now
+	rl.tokens = min(rl.tokens+elapsed*rl.rate, rl.burst)
+}
+
+// ParseMethodCosts parses comma separated list of method=cost pairs, e.g. "eth_getTransactionReceipt=15"
+func ParseMethodCosts(list string) (map[string]int, error) {
+	costs := make(map[string]int)
+	for _, pair := range strings.Split(list, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		method, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid method cost '%s', expected method=cost", pair)
+		}
+
+		cost, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid cost of method '%s': %s", method, value)
+		}
+		costs[strings.TrimSpace(method)] = cost
+	}
+	return costs, nil
 }
@@ -0,0 +1,76 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// RateLimiterTestSuite proves that the token bucket allows bursts, blocks when exhausted and obeys the context
+type RateLimiterTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+// this function executes before each test case
+func (s *RateLimiterTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *RateLimiterTestSuite) TestBurstAndWait() {
+	r := s.Require()
+	rl := NewRateLimiter(100, 5, nil)
+
+	start := time.Now()
+	r.NoError(rl.Wait(s.ctx, 5))
+	r.Less(time.Since(start), 5*time.Millisecond, "burst must be served immediately")
+
+	// 10 more credits at 100 credits per second take ~100ms
+	r.NoError(rl.Wait(s.ctx, 10))
+	r.GreaterOrEqual(time.Since(start), 90*time.Millisecond)
+}
+
+func (s *RateLimiterTestSuite) TestContextCancellation() {
+	r := s.Require()
+	rl := NewRateLimiter(1, 1, nil)
+	r.NoError(rl.Wait(s.ctx, 1))
+
+	ctx, cancel := context.WithTimeout(s.ctx, 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	r.ErrorIs(rl.Wait(ctx, 1), context.DeadlineExceeded)
+	r.Less(time.Since(start), 500*time.Millisecond, "canceled wait must return right away")
+
+	// the canceled reservation is given back
+	rl.mu.Lock()
+	r.InDelta(0, rl.tokens, 0.1)
+	rl.mu.Unlock()
+
+	canceled, cancel := context.WithCancel(s.ctx)
+	cancel()
+	r.ErrorIs(rl.Wait(canceled, 1), context.Canceled)
+}
+
+func (s *RateLimiterTestSuite) TestMethodCosts() {
+	r := s.Require()
+
+	costs, err := ParseMethodCosts(" eth_getTransactionByHash=17, eth_getTransactionReceipt = 15,")
+	r.NoError(err)
+
+	rl := NewRateLimiter(10, 0, costs)
+	r.Equal(17, rl.Cost(methodTransactionByHash))
+	r.Equal(15, rl.Cost(methodTransactionReceipt))
+	r.Equal(1, rl.Cost("eth_blockNumber"))
+
+	_, err = ParseMethodCosts("eth_blockNumber")
+	r.Error(err)
+	_, err = ParseMethodCosts("eth_blockNumber=ten")
+	r.Error(err)
+}
+
+func TestRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimiterTestSuite))
+}
@@ -0,0 +1,139 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// classes of the node errors, every error returned by the retry policy wraps exactly one of them
+var (
+	// ErrTransient is a failure that is expected to go away (timeouts, 429, 5xx, connection reset)
+	ErrTransient = errors.New("transient node error")
+	// ErrNotFound is reported when the node does not know the requested data
+	ErrNotFound = errors.New("not found")
+	// ErrPermanent is a failure that retrying would not fix
+	ErrPermanent = errors.New("permanent node error")
+)
+
+// limitExceededCode is the json-rpc error code used by the providers when the rate limit is exceeded
+const limitExceededCode = -32005
+
+// internalErrorCode is the json-rpc error code of the node failing to process a valid request
+const internalErrorCode = -32603
+
+// RetryPolicy retries the transient failures with jittered exponential backoff
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Do calls fn until it succeeds, fails with non-transient error, the attempts are exhausted or
+// the context is done; the returned error is classified as ErrTransient, ErrNotFound or ErrPermanent
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		class := classify(err)
+		if class != ErrTransient || attempt >= p.MaxAttempts || ctx.Err() != nil {
+			return classified(err)
+		}
+
+		delay := p.backoff(attempt)
+		log.Debugf("retrying node call in %v (attempt %d of %d): %v", delay, attempt+1, p.MaxAttempts, err)
+
+		select {
+		case <-ctx.Done():
+			return classified(err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt, the full jitter keeps it between half and the whole
+// exponential delay, so the concurrent callers don't retry in lockstep
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec // jitter does not need cryptographic randomness
+}
+
+// classified wraps the error with its class, unless it is already classified
+func classified(err error) error {
+	class := classify(err)
+	if errors.Is(err, class) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", class, err)
+}
+
+// classify tells the class of the node error
+func classify(err error) error {
+	// already classified, e.g. by nested policy
+	for _, class := range []error{ErrTransient, ErrPermanent, ErrNotFound} {
+		if errors.Is(err, class) {
+			return class
+		}
+	}
+
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		return ErrNotFound
+	case isTransient(err):
+		return ErrTransient
+	default:
+		return ErrPermanent
+	}
+}
+
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == limitExceededCode
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrNoNodeAvailable) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// IsNotFound reports whether the error is caused only by data unknown to the node
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) && !errors.Is(err, ErrTransient) && !errors.Is(err, ErrPermanent)
+}
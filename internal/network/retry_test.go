@@ -0,0 +1,128 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+type rpcError struct {
+	code int
+}
+
+func (e rpcError) Error() string  { return fmt.Sprintf("rpc error %d", e.code) }
+func (e rpcError) ErrorCode() int { return e.code }
+
+type RetryTestSuite struct {
+	suite.Suite
+	policy RetryPolicy
+}
+
+// this function executes before each test case
+func (s *RetryTestSuite) SetupTest() {
+	s.policy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+}
+
+func (s *RetryTestSuite) TestClassify() {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "not found", err: ethereum.NotFound, want: ErrNotFound},
+		{name: "too many requests", err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, want: ErrTransient},
+		{name: "bad gateway", err: rpc.HTTPError{StatusCode: http.StatusBadGateway}, want: ErrTransient},
+		{name: "unauthorized", err: rpc.HTTPError{StatusCode: http.StatusUnauthorized}, want: ErrPermanent},
+		{name: "limit exceeded", err: rpcError{code: limitExceededCode}, want: ErrTransient},
+		{name: "invalid params", err: rpcError{code: -32602}, want: ErrPermanent},
+		{name: "connection reset", err: fmt.Errorf("post: %w", syscall.ECONNRESET), want: ErrTransient},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, want: ErrTransient},
+		{name: "canceled", err: context.Canceled, want: ErrPermanent},
+		{name: "no node available", err: ErrNoNodeAvailable, want: ErrTransient},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := classified(tt.err)
+			s.ErrorIs(err, tt.want)
+			s.Contains(err.Error(), tt.err.Error(), "the original error must be preserved")
+		})
+	}
+}
+
+func (s *RetryTestSuite) TestRetriesTransientErrors() {
+	calls := 0
+	err := s.policy.Do(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return rpc.HTTPError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil
+	})
+
+	s.NoError(err)
+	s.Equal(3, calls)
+}
+
+func (s *RetryTestSuite) TestGivesUpAfterMaxAttempts() {
+	calls := 0
+	err := s.policy.Do(context.Background(), func() error {
+		calls++
+		return rpc.HTTPError{StatusCode: http.StatusTooManyRequests}
+	})
+
+	s.ErrorIs(err, ErrTransient)
+	s.Equal(s.policy.MaxAttempts, calls)
+}
+
+func (s *RetryTestSuite) TestDoesNotRetryOtherErrors() {
+	for _, fail := range []error{ethereum.NotFound, errors.New("invalid argument")} {
+		calls := 0
+		err := s.policy.Do(context.Background(), func() error {
+			calls++
+			return fail
+		})
+
+		s.Error(err)
+		s.NotErrorIs(err, ErrTransient)
+		s.Equal(1, calls)
+	}
+}
+
+func (s *RetryTestSuite) TestStopsOnCanceledContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.policy.BaseDelay, s.policy.MaxDelay = time.Minute, time.Minute
+
+	calls := 0
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := s.policy.Do(ctx, func() error {
+		calls++
+		return rpc.HTTPError{StatusCode: http.StatusBadGateway}
+	})
+
+	s.ErrorIs(err, ErrTransient)
+	s.Equal(1, calls)
+}
+
+func (s *RetryTestSuite) TestBackoffIsCapped() {
+	for attempt := 1; attempt < 70; attempt++ {
+		delay := s.policy.backoff(attempt)
+		s.LessOrEqual(delay, s.policy.MaxDelay)
+		s.GreaterOrEqual(delay, 0*time.Millisecond)
+	}
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
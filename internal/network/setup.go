@@ -2,13 +2,17 @@ package network
 
 import (
 	"context"
-	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/network/fixture"
 	"ethereum-fetcher/internal/store/pg/models"
 
-	"github.com/ethereum/go-ethereum/ethclient"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -18,16 +22,47 @@ const maxFetchWorkers = 20
 type EthNode struct {
 	ctx         context.Context
 	vp          *viper.Viper
-	client      *ethclient.Client
+	chainID     int64
+	pool        *NodePool
 	rateLimiter *RateLimiter
+	retry       RetryPolicy
 	workersChan chan struct{}
 	tasksChan   chan TxTask
+	batchSize   int
+	batchLinger time.Duration
+	creditModel string
+	flightsMu   sync.Mutex
+	flights     map[string]*flight
+	blocks      *blockCache[*models.Block]
+	proofs      *blockCache[*blockProof]
+	tracing     atomic.Bool
+	verifying   bool
+	// blockReceipts is switched off, when the node does not serve all the receipts of the block at once
+	blockReceipts atomic.Bool
+	prewarm       bool
 }
 
-func NewEthNode(ctx context.Context, vp *viper.Viper) *EthNode {
-	client, err := ethclient.Dial(vp.GetString(cmd.EthNodeURL))
+// NewEthNode creates the provider of the chain, served by the provided node urls
+func NewEthNode(ctx context.Context, vp *viper.Viper, chainID int64, urls []string) *EthNode {
+	var transport http.RoundTripper
+	if recordDir := vp.GetString(cmd.NodeRecordDir); recordDir != "" {
+		// the calls of each chain are recorded into its own directory, e.g. the same block number differs by chain
+		recorder, err := fixture.NewRecorder(filepath.Join(recordDir, strconv.FormatInt(chainID, 10)), nil)
+		if err != nil {
+			log.Fatalf("Failed to set up the node recorder of chain %d: %v", chainID, err)
+		}
+		transport = recorder
+	}
+
+	pool, err := NewNodePool(ctx, urls, vp.GetInt(cmd.NodeEjectAfter), vp.GetDuration(cmd.NodeProbeInterval),
+		transport)
+	if err != nil {
+		log.Fatalf("Failed to set up the Ethereum clients of chain %d: %v", chainID, err)
+	}
+
+	methodCosts, err := ParseMethodCosts(vp.GetString(cmd.NodeMethodCosts))
 	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+		log.Fatalf("Failed to parse the node method costs: %v", err)
 	}
 
 	workersChan := make(chan struct{}, maxFetchWorkers)
@@ -36,61 +71,54 @@ func NewEthNode(ctx context.Context, vp *viper.Viper) *EthNode {
 	node := &EthNode{
 		ctx:         ctx,
 		vp:          vp,
-		client:      client,
-		rateLimiter: NewRateLimiter(ctx, vp.GetInt(cmd.NodeRateLimit), time.Second),
+		chainID:     chainID,
+		pool:        pool,
+		rateLimiter: NewRateLimiter(vp.GetInt(cmd.NodeRateLimit), vp.GetInt(cmd.NodeRateBurst), methodCosts),
+		retry: RetryPolicy{
+			MaxAttempts: vp.GetInt(cmd.NodeRetryMaxAttempts),
+			BaseDelay:   vp.GetDuration(cmd.NodeRetryBaseDelay),
+			MaxDelay:    vp.GetDuration(cmd.NodeRetryMaxDelay),
+		},
 		workersChan: workersChan,
 		tasksChan:   tasksChan,
+		batchSize:   vp.GetInt(cmd.NodeBatchSize),
+		batchLinger: vp.GetDuration(cmd.NodeBatchLinger),
+		creditModel: vp.GetString(cmd.NodeCreditModel),
+		flights:     make(map[string]*flight),
+		blocks:      newBlockCache[*models.Block](maxCachedBlocks),
+		proofs:      newBlockCache[*blockProof](maxCachedBlocks),
+		verifying:   vp.GetBool(cmd.NodeVerifyReceipts),
+		prewarm:     vp.GetBool(cmd.NodePrewarmBlocks),
 	}
 
-	go func() {
-		for {
+	node.tracing.Store(vp.GetBool(cmd.NodeTraceCalls))
+	node.blockReceipts.Store(true)
+
+	go node.dispatch()
+
+	return node
+}
+
+// dispatch gathers the scheduled tasks into batches and hands them over to the workers
+func (n *EthNode) dispatch() {
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case task := <-n.tasksChan:
+			batch := n.collectBatch(task)
+
 			select {
-			case <-ctx.Done():
+			case <-n.ctx.Done():
 				return
-			case task := <-tasksChan:
-				select {
-				// provided context must be a multiplexed version of app context and http request context
-				case <-task.Ctx.Done():
-					select {
-					case task.ResChan <- TxResult{Tx: &models.Transaction{TXHash: task.TxHash}, Err: fmt.Errorf("task canceled")}:
-					default:
-					}
-					close(task.ResChan)
-					select {
-					case <-ctx.Done():
-						return
-					default:
-						continue // Proceed to the next task in the loop
-					}
-				case workersChan <- struct{}{}:
-					// get permit to work
-					go func(task TxTask) {
-						defer func() {
-							close(task.ResChan)
-							// at the end, return the permit, for another worker to obtain it
-							<-workersChan
-						}()
-						log.Infof("start processing task: %s at time %v", task.TxHash, time.Now())
-
-						tx, err := node.GetTransactionByHash(task)
-						if tx == nil {
-							tx = &models.Transaction{TXHash: task.TxHash}
-						}
-						log.Infof("completed task: %s at time %v", task.TxHash, time.Now())
-						select {
-						case <-task.Ctx.Done():
-							// Context canceled
-							select {
-							case task.ResChan <- TxResult{Tx: tx, Err: fmt.Errorf("task canceled")}:
-							default:
-							}
-						case task.ResChan <- TxResult{Tx: tx, Err: err}:
-						}
-					}(task)
-				}
+			case n.workersChan <- struct{}{}:
+				// get permit to work
+				go func(batch []TxTask) {
+					// at the end, return the permit, for another worker to obtain it
+					defer func() { <-n.workersChan }()
+					n.processBatch(batch)
+				}(batch)
 			}
 		}
-	}()
-
-	return node
+	}
 }
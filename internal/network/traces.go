@@ -0,0 +1,121 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+)
+
+// json-rpc method used to trace the internal calls of the transaction
+const methodTraceTransaction = "debug_traceTransaction"
+
+// methodNotFoundCode is the json-rpc error code of the methods the node does not serve
+const methodNotFoundCode = -32601
+
+// callFrame is the call reported by the callTracer, along with the nested calls it made
+type callFrame struct {
+	Type  string          `json:"type"`
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value"`
+	Gas   hexutil.Uint64  `json:"gas"`
+	Error string          `json:"error"`
+	Calls []*callFrame    `json:"calls"`
+}
+
+// attachTraces adds the call frames of the mined transaction to its record, when the tracing is on; the node
+// without the debug namespace switches the tracing off, and the transactions are stored without traces since
+func (n *EthNode) attachTraces(ctx context.Context, record *store.TxRecord) error {
+	if record == nil || record.Pending || !n.tracing.Load() {
+		return nil
+	}
+
+	root := new(callFrame)
+	err := n.callNode(ctx, methodTraceTransaction, func(client *ethclient.Client) error {
+		return client.Client().CallContext(ctx, root, methodTraceTransaction, common.HexToHash(record.TXHash),
+			map[string]any{"tracer": "callTracer"})
+	})
+	switch {
+	case err == nil:
+	case isMethodUnsupported(err):
+		if n.tracing.CompareAndSwap(true, false) {
+			log.Warnf("the node of chain %d does not support %s, the tracing is switched off: %v",
+				n.chainID, methodTraceTransaction, err)
+		}
+		return nil
+	case errors.Is(err, ErrTransient) || ctx.Err() != nil:
+		return fmt.Errorf("failed to trace transaction: %w", err)
+	default:
+		// e.g. the state of an old block is already pruned by the node, the transaction is stored without traces
+		log.Warnf("cannot trace transaction '%s': %v", record.TXHash, err)
+		return nil
+	}
+
+	record.Traces = n.newTransactionTraces(record.TXHash, root)
+	return nil
+}
+
+// newTransactionTraces flattens the call frames into the stored model, in the order they were called
+func (n *EthNode) newTransactionTraces(txHash string, root *callFrame) []*models.TransactionTrace {
+	traces := make([]*models.TransactionTrace, 0)
+
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		trace := &models.TransactionTrace{
+			ChainID:     n.chainID,
+			TXHash:      txHash,
+			TraceIndex:  len(traces),
+			CallType:    frame.Type,
+			FromAddress: frame.From.Hex(),
+			Value:       "0",
+			// nolint:gosec // the gas is far below the max int64
+			Gas:   int64(frame.Gas),
+			Depth: depth,
+		}
+		if frame.To != nil {
+			trace.ToAddress = null.StringFrom(frame.To.Hex())
+		}
+		if frame.Value != nil {
+			trace.Value = frame.Value.ToInt().String()
+		}
+		if frame.Error != "" {
+			trace.Error = null.StringFrom(frame.Error)
+		}
+		traces = append(traces, trace)
+
+		for _, call := range frame.Calls {
+			walk(call, depth+1)
+		}
+	}
+	walk(root, 0)
+
+	return traces
+}
+
+// isMethodUnsupported reports whether the node does not serve the method at all, as opposed to failing to serve
+// the particular call, e.g. the debug namespace is not enabled
+func isMethodUnsupported(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.ErrorCode() == methodNotFoundCode {
+		return true
+	}
+
+	message := strings.ToLower(rpcErr.Error())
+	return strings.Contains(message, "method not found") ||
+		strings.Contains(message, "unsupported method") ||
+		strings.Contains(message, "does not exist")
+}
@@ -0,0 +1,120 @@
+package network
+
+import (
+	"math/big"
+
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	StandardERC20   = "erc20"
+	StandardERC721  = "erc721"
+	StandardERC1155 = "erc1155"
+)
+
+var (
+	// Transfer(address indexed from, address indexed to, uint256 value) of ERC-20, ERC-721 has the same
+	// signature, but with indexed tokenId
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+	transferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids,
+	// uint256[] values)
+	transferBatchTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+
+	transferBatchData = mustArguments("uint256[]", "uint256[]")
+)
+
+// newTokenTransfers extracts the token movements out of the standard transfer events of the receipt logs,
+// the malformed events (e.g. of non-compliant tokens) are skipped
+func (n *EthNode) newTokenTransfers(txHash string, logs []*types.Log) []*models.TokenTransfer {
+	var transfers []*models.TokenTransfer
+	for _, l := range logs {
+		if len(l.Topics) == 0 {
+			continue
+		}
+
+		transfer := &models.TokenTransfer{
+			ChainID:      n.chainID,
+			TXHash:       txHash,
+			LogIndex:     int(l.Index),
+			TokenAddress: l.Address.Hex(),
+		}
+
+		switch {
+		case l.Topics[0] == transferTopic && len(l.Topics) == 3 && len(l.Data) == 32:
+			transfer.Standard = StandardERC20
+			transfer.FromAddress = topicAddress(l.Topics[1])
+			transfer.ToAddress = topicAddress(l.Topics[2])
+			transfer.Amount = new(big.Int).SetBytes(l.Data).String()
+			transfers = append(transfers, transfer)
+
+		case l.Topics[0] == transferTopic && len(l.Topics) == 4 && len(l.Data) == 0:
+			transfer.Standard = StandardERC721
+			transfer.FromAddress = topicAddress(l.Topics[1])
+			transfer.ToAddress = topicAddress(l.Topics[2])
+			transfer.TokenID = null.StringFrom(l.Topics[3].Big().String())
+			transfer.Amount = "1"
+			transfers = append(transfers, transfer)
+
+		case l.Topics[0] == transferSingleTopic && len(l.Topics) == 4 && len(l.Data) == 64:
+			setERC1155Parties(transfer, l.Topics)
+			transfer.TokenID = null.StringFrom(new(big.Int).SetBytes(l.Data[:32]).String())
+			transfer.Amount = new(big.Int).SetBytes(l.Data[32:]).String()
+			transfers = append(transfers, transfer)
+
+		case l.Topics[0] == transferBatchTopic && len(l.Topics) == 4:
+			values, err := transferBatchData.Unpack(l.Data)
+			if err != nil {
+				continue
+			}
+			ids, _ := values[0].([]*big.Int)
+			amounts, _ := values[1].([]*big.Int)
+			if len(ids) != len(amounts) {
+				continue
+			}
+
+			for i := range ids {
+				batchTransfer := *transfer
+				setERC1155Parties(&batchTransfer, l.Topics)
+				batchTransfer.BatchIndex = i
+				batchTransfer.TokenID = null.StringFrom(ids[i].String())
+				batchTransfer.Amount = amounts[i].String()
+				transfers = append(transfers, &batchTransfer)
+			}
+		}
+	}
+	return transfers
+}
+
+// setERC1155Parties sets the operator and the parties of the ERC-1155 transfer out of its indexed topics
+func setERC1155Parties(transfer *models.TokenTransfer, topics []common.Hash) {
+	transfer.Standard = StandardERC1155
+	transfer.Operator = null.StringFrom(topicAddress(topics[1]))
+	transfer.FromAddress = topicAddress(topics[2])
+	transfer.ToAddress = topicAddress(topics[3])
+}
+
+// topicAddress returns the address of the indexed event argument
+func topicAddress(topic common.Hash) string {
+	return common.BytesToAddress(topic.Bytes()).Hex()
+}
+
+// mustArguments describes the unnamed, non-indexed arguments of the event by their types
+func mustArguments(typeNames ...string) abi.Arguments {
+	arguments := make(abi.Arguments, 0, len(typeNames))
+	for _, typeName := range typeNames {
+		typ, err := abi.NewType(typeName, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		arguments = append(arguments, abi.Argument{Type: typ})
+	}
+	return arguments
+}
@@ -0,0 +1,107 @@
+package network
+
+import (
+	"math/big"
+	"testing"
+
+	"ethereum-fetcher/cmd"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransfersTestSuite struct {
+	suite.Suite
+}
+
+func (s *TransfersTestSuite) TestNewTokenTransfers() {
+	token := common.HexToAddress("0x4c16D8C078eF6B56700C1BE19a336915962df072")
+	operator := common.HexToAddress("0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09")
+	from := common.HexToAddress("0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70")
+	to := common.HexToAddress("0xAa449E0226B45D2044B1f721D04001fDe02ABb08")
+
+	batchData, err := transferBatchData.Pack([]*big.Int{big.NewInt(7), big.NewInt(8)},
+		[]*big.Int{big.NewInt(100), big.NewInt(200)})
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name     string
+		log      *types.Log
+		standard string
+		tokenIDs []string
+		amounts  []string
+	}{
+		{
+			name: "with erc20 transfer, it returns the amount",
+			log: &types.Log{Topics: []common.Hash{transferTopic, hashOf(from), hashOf(to)},
+				Data: common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)},
+			standard: StandardERC20,
+			tokenIDs: []string{""},
+			amounts:  []string{"1000"},
+		},
+		{
+			name:     "with erc721 transfer, it returns the token id",
+			log:      &types.Log{Topics: []common.Hash{transferTopic, hashOf(from), hashOf(to), common.BigToHash(big.NewInt(42))}},
+			standard: StandardERC721,
+			tokenIDs: []string{"42"},
+			amounts:  []string{"1"},
+		},
+		{
+			name: "with erc1155 single transfer, it returns the token id and amount",
+			log: &types.Log{Topics: []common.Hash{transferSingleTopic, hashOf(operator), hashOf(from), hashOf(to)},
+				Data: append(common.BigToHash(big.NewInt(5)).Bytes(), common.BigToHash(big.NewInt(3)).Bytes()...)},
+			standard: StandardERC1155,
+			tokenIDs: []string{"5"},
+			amounts:  []string{"3"},
+		},
+		{
+			name: "with erc1155 batch transfer, it returns a transfer per token",
+			log: &types.Log{Topics: []common.Hash{transferBatchTopic, hashOf(operator), hashOf(from), hashOf(to)},
+				Data: batchData},
+			standard: StandardERC1155,
+			tokenIDs: []string{"7", "8"},
+			amounts:  []string{"100", "200"},
+		},
+		{
+			name: "with malformed transfer, it is skipped",
+			log:  &types.Log{Topics: []common.Hash{transferTopic, hashOf(from)}, Data: []byte{0x01}},
+		},
+		{
+			name: "with other event, it is skipped",
+			log:  &types.Log{Topics: []common.Hash{common.HexToHash("0x01")}},
+		},
+	}
+
+	n := &EthNode{chainID: cmd.SepoliaChainID}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.log.Address = token
+			tt.log.Index = 3
+
+			transfers := n.newTokenTransfers("0x11", []*types.Log{tt.log})
+			s.Require().Len(transfers, len(tt.amounts))
+
+			for i, transfer := range transfers {
+				s.Equal(tt.standard, transfer.Standard)
+				s.Equal(int64(cmd.SepoliaChainID), transfer.ChainID)
+				s.Equal(3, transfer.LogIndex)
+				s.Equal(i, transfer.BatchIndex)
+				s.Equal(token.Hex(), transfer.TokenAddress)
+				s.Equal(from.Hex(), transfer.FromAddress)
+				s.Equal(to.Hex(), transfer.ToAddress)
+				s.Equal(tt.tokenIDs[i], transfer.TokenID.String)
+				s.Equal(tt.amounts[i], transfer.Amount)
+				s.Equal(tt.standard == StandardERC1155, transfer.Operator.Valid)
+			}
+		})
+	}
+}
+
+func TestTransfersTestSuite(t *testing.T) {
+	suite.Run(t, new(TransfersTestSuite))
+}
+
+func hashOf(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
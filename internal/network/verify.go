@@ -0,0 +1,123 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ethereum-fetcher/internal/store"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/trie"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrVerificationFailed is returned when the data of the node does not match the roots of the block header,
+// i.e. the node is faulty or malicious - such data must not be stored
+var ErrVerificationFailed = errors.New("node data failed verification")
+
+// blockProof is the verified content of the block - the hashes of its transactions and of the consensus
+// encoding of their receipts, by position in the block
+type blockProof struct {
+	txHashes      []common.Hash
+	receiptHashes []common.Hash
+}
+
+// verifyReceipt checks the mined transaction and its receipt against the transactions and the receipts roots
+// of the block, when the verification is on; the verified record gets its verified flag set
+func (n *EthNode) verifyReceipt(ctx context.Context, record *store.TxRecord, ethTX *types.Transaction,
+	receipt *types.Receipt) error {
+	if !n.verifying || record == nil || receipt == nil {
+		return nil
+	}
+
+	// the transactions of the same block share the proof, since rebuilding the tries takes all of its receipts
+	proof, err := n.proofs.get(ctx, receipt.BlockHash.Hex(), func(ctx context.Context) (*blockProof, error) {
+		return n.fetchBlockProof(ctx, receipt.BlockHash)
+	})
+	if err != nil {
+		return err
+	}
+
+	index := receipt.TransactionIndex
+	if index >= uint(len(proof.txHashes)) || proof.txHashes[index] != ethTX.Hash() {
+		return n.verificationFailed("transaction '%s' is not at position %d of block %s", ethTX.Hash().Hex(),
+			index, receipt.BlockHash.Hex())
+	}
+
+	encoded, err := receipt.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("cannot encode receipt of transaction '%s': %w", ethTX.Hash().Hex(), err)
+	}
+	if crypto.Keccak256Hash(encoded) != proof.receiptHashes[index] {
+		return n.verificationFailed("receipt of transaction '%s' does not match the receipts root of block %s",
+			ethTX.Hash().Hex(), receipt.BlockHash.Hex())
+	}
+
+	record.Verified = true
+	return nil
+}
+
+// fetchBlockProof fetches the block with all of its receipts and rebuilds its proof
+func (n *EthNode) fetchBlockProof(ctx context.Context, blockHash common.Hash) (*blockProof, error) {
+	var block *types.Block
+	err := n.callNode(ctx, methodBlockByHash, func(client *ethclient.Client) error {
+		var err error
+		block, err = client.BlockByHash(ctx, blockHash)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %s: %w", blockHash.Hex(), err)
+	}
+
+	receipts, err := n.fetchBlockReceipts(ctx, blockHash, block.Transactions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipts of block %s: %w", blockHash.Hex(), err)
+	}
+
+	return n.newBlockProof(blockHash, block, receipts)
+}
+
+// newBlockProof rebuilds the tries of the block transactions and receipts, their roots must match the header,
+// which in turn must match the block hash
+func (n *EthNode) newBlockProof(blockHash common.Hash, block *types.Block, receipts types.Receipts) (
+	*blockProof, error) {
+	header := block.Header()
+	switch {
+	case block.Hash() != blockHash:
+		return nil, n.verificationFailed("the header of block %s hashes to %s", blockHash.Hex(), block.Hash().Hex())
+	case types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)) != header.TxHash:
+		return nil, n.verificationFailed("the transactions of block %s do not match its transactions root",
+			blockHash.Hex())
+	case len(receipts) != len(block.Transactions()),
+		types.DeriveSha(receipts, trie.NewStackTrie(nil)) != header.ReceiptHash:
+		return nil, n.verificationFailed("the receipts of block %s do not match its receipts root", blockHash.Hex())
+	}
+
+	proof := &blockProof{
+		txHashes:      make([]common.Hash, 0, len(receipts)),
+		receiptHashes: make([]common.Hash, 0, len(receipts)),
+	}
+	for i, ethTX := range block.Transactions() {
+		encoded, err := receipts[i].MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode receipt of transaction '%s': %w", ethTX.Hash().Hex(), err)
+		}
+		proof.txHashes = append(proof.txHashes, ethTX.Hash())
+		proof.receiptHashes = append(proof.receiptHashes, crypto.Keccak256Hash(encoded))
+	}
+
+	return proof, nil
+}
+
+// verificationFailed raises the alert about the node data, that does not match the block header, and returns
+// the error refusing it
+func (n *EthNode) verificationFailed(format string, args ...any) error {
+	err := fmt.Errorf("%w: %s", ErrVerificationFailed, fmt.Sprintf(format, args...))
+	log.WithField("alert", "verification").Errorf("the node of chain %d returned unverifiable data: %v",
+		n.chainID, err)
+	return err
+}
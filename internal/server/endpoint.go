@@ -34,9 +34,35 @@ func (ep *EndPoint) Register(router *mux.Router) {
 		NewAuthBearerMiddleware(jwtSecret, ep.GetTransactionsByHashes, true).Authenticate).Methods("GET")
 	router.HandleFunc("/lime/eth/{rlphex}",
 		NewAuthBearerMiddleware(jwtSecret, ep.GetTransactionsByRLP, true).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/block/{number}", ep.GetBlockTransactions).Methods("GET")
 	router.HandleFunc("/lime/all", ep.GetAllTransactions).Methods("GET")
 	router.HandleFunc("/lime/my",
 		NewAuthBearerMiddleware(jwtSecret, ep.GetMyTransactions, false).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/my/transfers",
+		NewAuthBearerMiddleware(jwtSecret, ep.GetMyTokenTransfers, false).Authenticate).Methods("GET")
+	// the same endpoints, for the chain provided by its ID or well-known name
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/eth",
+		NewAuthBearerMiddleware(jwtSecret, ep.GetTransactionsByHashes, true).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/eth/{rlphex}",
+		NewAuthBearerMiddleware(jwtSecret, ep.GetTransactionsByRLP, true).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/block/{number}", ep.GetBlockTransactions).Methods("GET")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/all", ep.GetAllTransactions).Methods("GET")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/my",
+		NewAuthBearerMiddleware(jwtSecret, ep.GetMyTransactions, false).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/my/transfers",
+		NewAuthBearerMiddleware(jwtSecret, ep.GetMyTokenTransfers, false).Authenticate).Methods("GET")
+	router.HandleFunc("/lime/abi/{address}",
+		NewAuthBearerMiddleware(jwtSecret, ep.UploadABI, false).Authenticate).Methods("POST")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/abi/{address}",
+		NewAuthBearerMiddleware(jwtSecret, ep.UploadABI, false).Authenticate).Methods("POST")
+	router.HandleFunc("/lime/watch",
+		NewAuthBearerMiddleware(jwtSecret, ep.WatchAddress, false).Authenticate).Methods("POST")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/watch",
+		NewAuthBearerMiddleware(jwtSecret, ep.WatchAddress, false).Authenticate).Methods("POST")
+	router.HandleFunc("/lime/tx",
+		NewAuthBearerMiddleware(jwtSecret, ep.BroadcastTransaction, false).Authenticate).Methods("POST")
+	router.HandleFunc("/lime/{chain:[a-z0-9]+}/tx",
+		NewAuthBearerMiddleware(jwtSecret, ep.BroadcastTransaction, false).Authenticate).Methods("POST")
 	router.HandleFunc("/lime/authenticate", ep.Authenticate).Methods("POST")
 
 	router.NotFoundHandler = http.HandlerFunc(NotImplemented)
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -8,18 +10,26 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/app"
+	"ethereum-fetcher/internal/decoder"
 	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
 
+	"github.com/ericlagergren/decimal"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
 // ErrValidationFailed describes an error when the key is not found
@@ -42,29 +52,317 @@ type requestGetTransactionsByRLP struct {
 	RLPHex string `validate:"required,max=3000,hexadecimal"`
 }
 
+type requestGetBlockTransactions struct {
+	Number string `validate:"required,max=20,numeric"`
+}
+
+type requestUploadABI struct {
+	Address string `validate:"required,len=42,hexadecimal"`
+}
+
+type responseUploadABI struct {
+	ChainID int64    `json:"chainId"`
+	Address string   `json:"address"`
+	Methods []string `json:"methods"`
+}
+
+type requestWatchAddress struct {
+	Address string `json:"address" validate:"required,len=42,hexadecimal"`
+}
+
+type responseWatchAddress struct {
+	ChainID int64  `json:"chainId"`
+	Address string `json:"address"`
+}
+
+type requestBroadcastTransaction struct {
+	RawTransaction string `json:"rawTransaction" validate:"required,max=262144,hexadecimal"`
+}
+
+// maxABISize limits the size of the uploaded contract ABI
+const maxABISize = 1 << 20
+
+type requestInclude struct {
+	Include []string `validate:"max=5,dive,oneof=logs traces"`
+}
+
+const (
+	includeLogs   = "logs"
+	includeTraces = "traces"
+)
+
+type requestPeriod struct {
+	Since time.Time
+	Until time.Time `validate:"omitempty,gtfield=Since"`
+}
+
+type requestPage struct {
+	Limit  int    `validate:"omitempty,min=1,max=1000"`
+	Cursor string `validate:"omitempty,max=200"`
+	Stream bool   `validate:"excluded_with=Limit Cursor"`
+}
+
+// defaultPageLimit is the limit of the page requested by cursor only
+const defaultPageLimit = 100
+
+// pageCursor is the content of the opaque cursor, the block number is left out for the pending transaction
+type pageCursor struct {
+	BlockNumber string `json:"b,omitempty" validate:"omitempty,max=20,numeric"`
+	Value       string `json:"v" validate:"required,max=78,number"`
+	TXHash      string `json:"h" validate:"required,len=66,hexadecimal"`
+}
+
+type requestFilter struct {
+	From             string `validate:"omitempty,len=42,hexadecimal"`
+	To               string `validate:"omitempty,len=42,hexadecimal"`
+	Contract         string `validate:"omitempty,len=42,hexadecimal"`
+	Status           string `validate:"omitempty,oneof=0 1"`
+	FromBlock        string `validate:"omitempty,max=20,number"`
+	ToBlock          string `validate:"omitempty,max=20,number"`
+	MinValue         string `validate:"omitempty,max=78,number"`
+	MaxValue         string `validate:"omitempty,max=78,number"`
+	ContractCreation string `validate:"omitempty,oneof=true false"`
+	Sort             string `validate:"omitempty,oneof=blockNumber -value"`
+}
+
+const sortValueDesc = "-value"
+
 type Transaction struct {
-	Hash            string      `json:"transactionHash"`
-	Status          int         `json:"transactionStatus"`
-	BlockHash       string      `json:"blockHash"`
-	BlockNumber     *big.Int    `json:"blockNumber"`
-	From            string      `json:"from"`
-	To              null.String `json:"to"`
-	ContractAddress null.String `json:"contractAddress"`
-	LogsCount       int         `json:"logsCount"`
-	Input           string      `json:"input"`
-	Value           string      `json:"value"`
+	ChainID              int64            `json:"chainId"`
+	Hash                 string           `json:"transactionHash"`
+	Status               null.Int         `json:"transactionStatus"`
+	BlockHash            null.String      `json:"blockHash"`
+	BlockNumber          *big.Int         `json:"blockNumber"`
+	From                 string           `json:"from"`
+	To                   null.String      `json:"to"`
+	ContractAddress      null.String      `json:"contractAddress"`
+	LogsCount            int              `json:"logsCount"`
+	Input                string           `json:"input"`
+	Value                string           `json:"value"`
+	Type                 null.Int         `json:"type"`
+	Nonce                null.Int64       `json:"nonce"`
+	GasLimit             null.Int64       `json:"gasLimit"`
+	GasPrice             null.String      `json:"gasPrice"`
+	MaxFeePerGas         null.String      `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas null.String      `json:"maxPriorityFeePerGas"`
+	MaxFeePerBlobGas     null.String      `json:"maxFeePerBlobGas"`
+	AccessList           null.JSON        `json:"accessList"`
+	GasUsed              null.Int64       `json:"gasUsed"`
+	EffectiveGasPrice    null.String      `json:"effectiveGasPrice"`
+	BlobGasUsed          null.Int64       `json:"blobGasUsed"`
+	BlobGasPrice         null.String      `json:"blobGasPrice"`
+	Fee                  null.String      `json:"fee"`
+	Pending              bool             `json:"pending,omitempty"`
+	Confirmations        uint64           `json:"confirmations"`
+	Finalized            bool             `json:"finalized"`
+	Verified             bool             `json:"verified"`
+	Timestamp            null.Time        `json:"timestamp"`
+	DecodedInput         *DecodedInput    `json:"decodedInput"`
+	TokenTransfers       []*TokenTransfer `json:"tokenTransfers"`
+	Logs                 []*Log           `json:"logs,omitempty"`
+	Traces               []*Trace         `json:"traces,omitempty"`
+}
+
+type TokenTransfer struct {
+	Hash       string      `json:"transactionHash"`
+	LogIndex   int         `json:"logIndex"`
+	BatchIndex int         `json:"batchIndex"`
+	Standard   string      `json:"standard"`
+	Token      string      `json:"token"`
+	Operator   null.String `json:"operator"`
+	From       string      `json:"from"`
+	To         string      `json:"to"`
+	TokenID    null.String `json:"tokenId"`
+	Amount     string      `json:"amount"`
+}
+
+type DecodedInput struct {
+	Method    string             `json:"method"`
+	Signature string             `json:"signature"`
+	Source    string             `json:"source"`
+	Arguments []*DecodedArgument `json:"arguments"`
+}
+
+type DecodedArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type Log struct {
+	LogIndex int      `json:"logIndex"`
+	Address  string   `json:"address"`
+	Topics   []string `json:"topics"`
+	Data     string   `json:"data"`
+	Removed  bool     `json:"removed"`
+}
+
+type Trace struct {
+	TraceIndex int         `json:"traceIndex"`
+	Type       string      `json:"type"`
+	From       string      `json:"from"`
+	To         null.String `json:"to"`
+	Value      string      `json:"value"`
+	Gas        int64       `json:"gas"`
+	Error      null.String `json:"error"`
+	Depth      int         `json:"depth"`
+}
+
+// newTransaction converts the stored transaction into its api representation, the confirmations are
+// counted against the provided head and finalized blocks
+func newTransaction(tx *models.Transaction, head, finalized uint64) *Transaction {
+	var blockNumber *big.Int
+	var confirmations uint64
+	if tx.BlockNumber.Big != nil {
+		blockNumber = new(big.Int)
+		tx.BlockNumber.Int(blockNumber)
+
+		if number := blockNumber.Uint64(); head >= number {
+			confirmations = head - number + 1
+		}
+	}
+
+	return &Transaction{
+		ChainID:              tx.ChainID,
+		Hash:                 tx.TXHash,
+		Status:               tx.TXStatus,
+		BlockHash:            tx.BlockHash,
+		BlockNumber:          blockNumber,
+		From:                 tx.FromAddress,
+		To:                   tx.ToAddress,
+		ContractAddress:      tx.ContractAddress,
+		LogsCount:            int(tx.LogsCount),
+		Input:                tx.Input,
+		Value:                tx.Value,
+		Type:                 tx.TXType,
+		Nonce:                tx.Nonce,
+		GasLimit:             tx.GasLimit,
+		GasPrice:             tx.GasPrice,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		AccessList:           tx.AccessList,
+		GasUsed:              tx.GasUsed,
+		EffectiveGasPrice:    tx.EffectiveGasPrice,
+		BlobGasUsed:          tx.BlobGasUsed,
+		BlobGasPrice:         tx.BlobGasPrice,
+		Fee:                  transactionFee(tx),
+		Pending:              tx.Pending,
+		Confirmations:        confirmations,
+		Finalized:            blockNumber != nil && finalized > 0 && blockNumber.Uint64() <= finalized,
+		Verified:             tx.Verified,
+	}
+}
+
+// transactionFee returns the execution fee paid by the mined transaction (gasUsed x effectiveGasPrice) in wei,
+// the blob fee (blobGasUsed x blobGasPrice) is not part of it
+func transactionFee(tx *models.Transaction) null.String {
+	if !tx.GasUsed.Valid || !tx.EffectiveGasPrice.Valid {
+		return null.String{}
+	}
+
+	gasPrice, ok := new(big.Int).SetString(tx.EffectiveGasPrice.String, 10)
+	if !ok {
+		return null.String{}
+	}
+
+	fee := new(big.Int).Mul(big.NewInt(tx.GasUsed.Int64), gasPrice)
+	return null.StringFrom(fee.String())
+}
+
+// newLog converts the stored receipt log into its api representation
+func newLog(txLog *models.TransactionLog) *Log {
+	return &Log{
+		LogIndex: txLog.LogIndex,
+		Address:  txLog.Address,
+		Topics:   txLog.Topics,
+		Data:     txLog.Data,
+		Removed:  txLog.Removed,
+	}
+}
+
+// newTrace converts the stored call frame into its api representation
+func newTrace(trace *models.TransactionTrace) *Trace {
+	return &Trace{
+		TraceIndex: trace.TraceIndex,
+		Type:       trace.CallType,
+		From:       trace.FromAddress,
+		To:         trace.ToAddress,
+		Value:      trace.Value,
+		Gas:        trace.Gas,
+		Error:      trace.Error,
+		Depth:      trace.Depth,
+	}
+}
+
+// newTokenTransfer converts the stored token transfer into its api representation
+func newTokenTransfer(transfer *models.TokenTransfer) *TokenTransfer {
+	return &TokenTransfer{
+		Hash:       transfer.TXHash,
+		LogIndex:   transfer.LogIndex,
+		BatchIndex: transfer.BatchIndex,
+		Standard:   transfer.Standard,
+		Token:      transfer.TokenAddress,
+		Operator:   transfer.Operator,
+		From:       transfer.FromAddress,
+		To:         transfer.ToAddress,
+		TokenID:    transfer.TokenID,
+		Amount:     transfer.Amount,
+	}
+}
+
+// newDecodedInput converts the decoded transaction input into its api representation
+func newDecodedInput(decoded *decoder.DecodedInput) *DecodedInput {
+	if decoded == nil {
+		return nil
+	}
+
+	res := &DecodedInput{
+		Method:    decoded.Method,
+		Signature: decoded.Signature,
+		Source:    decoded.Source,
+		Arguments: make([]*DecodedArgument, 0, len(decoded.Arguments)),
+	}
+	for _, argument := range decoded.Arguments {
+		res.Arguments = append(res.Arguments, &DecodedArgument{
+			Name:  argument.Name,
+			Type:  argument.Type,
+			Value: argument.Value,
+		})
+	}
+	return res
 }
 
 type responseGetTransactionsByHashes struct {
 	Transactions []*Transaction `json:"transactions"`
+	NotFound     []string       `json:"notFound,omitempty"`
 }
 
 type responseGetAllTransactions struct {
 	Transactions []*Transaction `json:"transactions"`
+	NextCursor   string         `json:"nextCursor,omitempty"`
+}
+
+type responseGetBlockTransactions struct {
+	Transactions []*Transaction `json:"transactions"`
+}
+
+type responseGetMyTokenTransfers struct {
+	Transfers []*TokenTransfer `json:"transfers"`
 }
 
 // GetTransactionsByHashes retrieves eth transactions by tx hashes
 func (ep *EndPoint) GetTransactionsByHashes(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	include, done := parseInclude(w, r)
+	if done {
+		return
+	}
+
 	txHashes := r.URL.Query()["transactionHashes"]
 
 	reqParams := requestGetTransactionsByHashes{TransactionHashes: txHashes}
@@ -77,16 +375,26 @@ func (ep *EndPoint) GetTransactionsByHashes(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	res, done := ep.getTransactionsByHashes(w, r, txHashes)
+	res, httpCode, done := ep.getTransactionsByHashes(w, r, chainID, txHashes, include)
 	if done {
 		return
 	}
 
-	writeJSONResponse(w, http.StatusOK, res)
+	writeJSONResponse(w, httpCode, res)
 }
 
 // GetTransactionsByRLP retrieves eth transactions by RLP encoded list of hashes
 func (ep *EndPoint) GetTransactionsByRLP(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	include, done := parseInclude(w, r)
+	if done {
+		return
+	}
+
 	rlpHex := mux.Vars(r)["rlphex"]
 
 	reqParams := requestGetTransactionsByRLP{RLPHex: rlpHex}
@@ -107,89 +415,405 @@ func (ep *EndPoint) GetTransactionsByRLP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	res, done := ep.getTransactionsByHashes(w, r, txHashes)
+	res, httpCode, done := ep.getTransactionsByHashes(w, r, chainID, txHashes, include)
 	if done {
 		return
 	}
 
-	writeJSONResponse(w, http.StatusOK, res)
+	writeJSONResponse(w, httpCode, res)
 }
 
-// GetAllTransactions retrieves all transactions stored in the database
-func (ep *EndPoint) GetAllTransactions(w http.ResponseWriter, _ *http.Request) {
-	txList, err := ep.ap.GetAllTransactions()
+// GetBlockTransactions retrieves all transactions of the block by its number
+func (ep *EndPoint) GetBlockTransactions(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	include, done := parseInclude(w, r)
+	if done {
+		return
+	}
+
+	reqParams := requestGetBlockTransactions{Number: mux.Vars(r)["number"]}
+
+	validate := validator.New()
+	err := validate.Struct(reqParams)
+	var number uint64
+	if err == nil {
+		number, err = strconv.ParseUint(reqParams.Number, 10, 64)
+	}
 	if err != nil {
-		log.Errorf("cannot retrieve all transactions: %v", err)
+		log.Errorf("cannot validate block number url path: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return
+	}
+
+	txList, err := ep.ap.GetBlockTransactions(r.Context(), chainID, number)
+	if errors.Is(err, app.ErrBlockNotFound) {
+		log.Errorf("cannot retrieve block transactions: %v", err)
+		writeJSONError(w, http.StatusNotFound, app.ErrBlockNotFound)
+		return
+	} else if errors.Is(err, app.ErrNodeUnavailable) {
+		log.Errorf("cannot retrieve block transactions: %v", err)
+		writeJSONError(w, http.StatusServiceUnavailable, app.ErrNodeUnavailable)
+		return
+	} else if errors.Is(err, app.ErrVerificationFailed) {
+		log.Errorf("cannot retrieve block transactions: %v", err)
+		writeJSONError(w, http.StatusBadGateway, app.ErrVerificationFailed)
+		return
+	} else if err != nil {
+		log.Errorf("cannot retrieve block transactions: %v", err)
 		writeInternalServerError(w)
 		return
 	}
 
-	res := responseGetAllTransactions{
-		Transactions: []*Transaction{},
+	res := responseGetBlockTransactions{}
+	res.Transactions, err = ep.newTransactions(r.Context(), chainID, txList, include)
+	if err != nil {
+		log.Errorf("cannot retrieve transaction details: %v", err)
+		writeInternalServerError(w)
+		return
 	}
 
-	for _, tx := range txList {
-		blockNumber := new(big.Int)
-		tx.BlockNumber.Int(blockNumber)
+	writeJSONResponse(w, http.StatusOK, res)
+}
+
+// GetAllTransactions retrieves all transactions stored in the database, filtered and sorted,
+// either page by page or streamed at once
+func (ep *EndPoint) GetAllTransactions(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
 
-		res.Transactions = append(res.Transactions,
-			&Transaction{
-				Hash:            tx.TXHash,
-				Status:          tx.TXStatus,
-				BlockHash:       tx.BlockHash,
-				BlockNumber:     blockNumber,
-				From:            tx.FromAddress,
-				To:              tx.ToAddress,
-				ContractAddress: tx.ContractAddress,
-				LogsCount:       int(tx.LogsCount),
-				Input:           tx.Input,
-				Value:           tx.Value,
-			},
-		)
+	include, done := parseInclude(w, r)
+	if done {
+		return
+	}
+
+	filter, done := parseFilter(w, r)
+	if done {
+		return
+	}
+
+	page, stream, done := parsePage(w, r)
+	if done {
+		return
+	}
+
+	if stream {
+		ep.streamTransactions(r.Context(), w, chainID, include, func(fn store.StreamFunc) error {
+			return ep.ap.StreamAllTransactions(r.Context(), chainID, filter, fn)
+		})
+		return
+	}
+
+	txList, next, err := ep.ap.GetAllTransactions(r.Context(), chainID, filter, page)
+	if err != nil {
+		log.Errorf("cannot retrieve all transactions: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	res := responseGetAllTransactions{NextCursor: encodeCursor(next)}
+	res.Transactions, err = ep.newTransactions(r.Context(), chainID, txList, include)
+	if err != nil {
+		log.Errorf("cannot retrieve transaction details: %v", err)
+		writeInternalServerError(w)
+		return
 	}
 
 	writeJSONResponse(w, http.StatusOK, res)
 }
 
-// GetMyTransactions retrieves "my" transactions stored in the database
+// GetMyTransactions retrieves "my" transactions stored in the database, filtered and sorted,
+// either page by page or streamed at once
 func (ep *EndPoint) GetMyTransactions(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	include, done := parseInclude(w, r)
+	if done {
+		return
+	}
+
+	filter, done := parseFilter(w, r)
+	if done {
+		return
+	}
+
+	page, stream, done := parsePage(w, r)
+	if done {
+		return
+	}
+
 	// extract the user ID, cannot be missing
 	userID, _ := r.Context().Value(userIDKey).(int)
 
-	txList, err := ep.ap.GetMyTransactions(userID)
+	if stream {
+		ep.streamTransactions(r.Context(), w, chainID, include, func(fn store.StreamFunc) error {
+			return ep.ap.StreamMyTransactions(r.Context(), chainID, userID, filter, fn)
+		})
+		return
+	}
+
+	txList, next, err := ep.ap.GetMyTransactions(r.Context(), chainID, userID, filter, page)
 	if err != nil {
 		log.Errorf("cannot retrieve my transactions: %v", err)
 		writeInternalServerError(w)
 		return
 	}
 
-	res := responseGetAllTransactions{
-		Transactions: []*Transaction{},
+	res := responseGetAllTransactions{NextCursor: encodeCursor(next)}
+	res.Transactions, err = ep.newTransactions(r.Context(), chainID, txList, include)
+	if err != nil {
+		log.Errorf("cannot retrieve transaction details: %v", err)
+		writeInternalServerError(w)
+		return
 	}
 
-	for _, tx := range txList {
-		blockNumber := new(big.Int)
-		tx.BlockNumber.Int(blockNumber)
+	writeJSONResponse(w, http.StatusOK, res)
+}
+
+// streamTransactions writes the streamed transactions as they come, in the same shape as the single page;
+// the status is sent along with the first chunk, so the failed stream can only be cut short
+func (ep *EndPoint) streamTransactions(ctx context.Context, w http.ResponseWriter, chainID int64,
+	include map[string]bool, stream func(fn store.StreamFunc) error) {
+	flusher, _ := w.(http.Flusher)
+	started := false
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := io.WriteString(w, `{"transactions":[`)
+		return err
+	}
+
+	err := stream(func(txList []*models.Transaction) error {
+		transactions, err := ep.newTransactions(ctx, chainID, txList, include)
+		if err != nil {
+			return err
+		}
+
+		if !started {
+			if err = start(); err != nil {
+				return err
+			}
+		} else if _, err = io.WriteString(w, ","); err != nil {
+			return err
+		}
+		for i, tx := range transactions {
+			if i > 0 {
+				if _, err = io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			encoded, err := json.Marshal(tx)
+			if err != nil {
+				return err
+			}
+			if _, err = w.Write(encoded); err != nil {
+				return err
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("cannot stream transactions: %v", err)
+		if !started {
+			writeInternalServerError(w)
+		}
+		return
+	}
+
+	if !started {
+		if err = start(); err != nil {
+			log.Errorf("cannot stream transactions: %v", err)
+			return
+		}
+	}
+	if _, err = io.WriteString(w, "]}"); err != nil {
+		log.Errorf("cannot stream transactions: %v", err)
+	}
+}
+
+// GetMyTokenTransfers retrieves the token transfers of "my" transactions stored in the database
+func (ep *EndPoint) GetMyTokenTransfers(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	// extract the user ID, cannot be missing
+	userID, _ := r.Context().Value(userIDKey).(int)
 
-		res.Transactions = append(res.Transactions,
-			&Transaction{
-				Hash:            tx.TXHash,
-				Status:          tx.TXStatus,
-				BlockHash:       tx.BlockHash,
-				BlockNumber:     blockNumber,
-				From:            tx.FromAddress,
-				To:              tx.ToAddress,
-				ContractAddress: tx.ContractAddress,
-				LogsCount:       int(tx.LogsCount),
-				Input:           tx.Input,
-				Value:           tx.Value,
-			},
-		)
+	transferList, err := ep.ap.GetMyTokenTransfers(r.Context(), chainID, userID)
+	if err != nil {
+		log.Errorf("cannot retrieve my token transfers: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	res := responseGetMyTokenTransfers{
+		Transfers: make([]*TokenTransfer, 0, len(transferList)),
+	}
+	for _, transfer := range transferList {
+		res.Transfers = append(res.Transfers, newTokenTransfer(transfer))
 	}
 
 	writeJSONResponse(w, http.StatusOK, res)
 }
 
+// UploadABI registers the ABI of the contract, used to decode the input of the transactions sent to it
+func (ep *EndPoint) UploadABI(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	address := mux.Vars(r)["address"]
+
+	validate := validator.New()
+	err := validate.Struct(requestUploadABI{Address: address})
+	if err != nil {
+		log.Errorf("cannot validate address url path: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxABISize))
+	if err != nil {
+		writeBadRequestError(w)
+		return
+	}
+
+	methods, err := ep.ap.UploadABI(r.Context(), chainID, address, string(body))
+	if errors.Is(err, app.ErrInvalidABI) {
+		log.Errorf("cannot upload contract abi: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, app.ErrInvalidABI)
+		return
+	} else if err != nil {
+		log.Errorf("cannot upload contract abi: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	res := responseUploadABI{
+		ChainID: chainID,
+		Address: strings.ToLower(address),
+		Methods: methods,
+	}
+	writeJSONResponse(w, http.StatusOK, res)
+}
+
+// WatchAddress registers the address watched by the user, whose transactions are stored as the user ones
+// once they are mined
+func (ep *EndPoint) WatchAddress(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeBadRequestError(w)
+		return
+	}
+
+	var watchRequest requestWatchAddress
+	if err = json.Unmarshal(body, &watchRequest); err != nil {
+		writeBadRequestError(w)
+		return
+	}
+
+	validate := validator.New()
+	if err = validate.Struct(watchRequest); err != nil {
+		log.Errorf("cannot validate watched address: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return
+	}
+
+	// extract the user ID, cannot be missing
+	userID, _ := r.Context().Value(userIDKey).(int)
+
+	if err = ep.ap.WatchAddress(r.Context(), chainID, userID, watchRequest.Address); err != nil {
+		log.Errorf("cannot watch address: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	res := responseWatchAddress{
+		ChainID: chainID,
+		Address: strings.ToLower(watchRequest.Address),
+	}
+	writeJSONResponse(w, http.StatusOK, res)
+}
+
+// BroadcastTransaction broadcasts the signed raw transaction and tracks it as pending transaction of the user
+func (ep *EndPoint) BroadcastTransaction(w http.ResponseWriter, r *http.Request) {
+	chainID, done := ep.resolveChain(w, r)
+	if done {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeBadRequestError(w)
+		return
+	}
+
+	var broadcastRequest requestBroadcastTransaction
+	if err = json.Unmarshal(body, &broadcastRequest); err != nil {
+		writeBadRequestError(w)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(broadcastRequest)
+	var rawTx []byte
+	if err == nil {
+		rawTx, err = hex.DecodeString(strings.TrimPrefix(strings.ToLower(broadcastRequest.RawTransaction), "0x"))
+	}
+	if err != nil {
+		log.Errorf("cannot validate raw transaction: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return
+	}
+
+	// extract the user ID, cannot be missing
+	userID, _ := r.Context().Value(userIDKey).(int)
+
+	tx, err := ep.ap.BroadcastTransaction(r.Context(), chainID, rawTx, userID)
+	if errors.Is(err, app.ErrInvalidTransaction) {
+		log.Errorf("cannot broadcast transaction: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, err)
+		return
+	} else if errors.Is(err, app.ErrNodeUnavailable) {
+		log.Errorf("cannot broadcast transaction: %v", err)
+		writeJSONError(w, http.StatusServiceUnavailable, app.ErrNodeUnavailable)
+		return
+	} else if err != nil {
+		log.Errorf("cannot broadcast transaction: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	transactions, err := ep.newTransactions(r.Context(), chainID, []*models.Transaction{tx}, nil)
+	if err != nil {
+		log.Errorf("cannot retrieve transaction details: %v", err)
+		writeInternalServerError(w)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, transactions[0])
+}
+
 func (ep *EndPoint) Authenticate(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -211,7 +835,7 @@ func (ep *EndPoint) Authenticate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := ep.ap.GetUser(authRequest.Username, authRequest.Password)
+	user, err := ep.ap.GetUser(r.Context(), authRequest.Username, authRequest.Password)
 	if err != nil {
 		log.Errorf("cannot get user info: %v", err)
 		writeInternalServerError(w)
@@ -235,8 +859,8 @@ func (ep *EndPoint) Authenticate(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, http.StatusOK, res)
 }
 
-func (ep *EndPoint) getTransactionsByHashes(w http.ResponseWriter, r *http.Request, txHashes []string,
-) (responseGetTransactionsByHashes, bool) {
+func (ep *EndPoint) getTransactionsByHashes(w http.ResponseWriter, r *http.Request, chainID int64,
+	txHashes []string, include map[string]bool) (responseGetTransactionsByHashes, int, bool) {
 	// unify the hash format
 	for i := range txHashes {
 		txHashes[i] = strings.ToLower(txHashes[i])
@@ -245,37 +869,335 @@ func (ep *EndPoint) getTransactionsByHashes(w http.ResponseWriter, r *http.Reque
 	// extract the user ID - zero value for "no user"
 	userID, _ := r.Context().Value(userIDKey).(int)
 
-	txList, err := ep.ap.GetTransactionsByHashes(r.Context(), txHashes, userID)
-	if err != nil {
+	res := responseGetTransactionsByHashes{}
+
+	txList, err := ep.ap.GetTransactionsByHashes(r.Context(), chainID, txHashes, userID)
+	if notFoundErr := new(app.NotFoundError); errors.As(err, &notFoundErr) {
+		// hashes unknown to the node are not a failure of the whole request
+		res.NotFound = notFoundErr.TxHashes
+	} else if errors.Is(err, app.ErrNodeUnavailable) {
+		log.Errorf("cannot retrieve transactions by hashes: %v", err)
+		writeJSONError(w, http.StatusServiceUnavailable, app.ErrNodeUnavailable)
+		return responseGetTransactionsByHashes{}, 0, true
+	} else if errors.Is(err, app.ErrVerificationFailed) {
+		log.Errorf("cannot retrieve transactions by hashes: %v", err)
+		writeJSONError(w, http.StatusBadGateway, app.ErrVerificationFailed)
+		return responseGetTransactionsByHashes{}, 0, true
+	} else if err != nil {
 		log.Errorf("cannot retrieve transactions by hashes: %v", err)
 		writeInternalServerError(w)
-		return responseGetTransactionsByHashes{}, true
+		return responseGetTransactionsByHashes{}, 0, true
 	}
 
-	res := responseGetTransactionsByHashes{
-		Transactions: []*Transaction{},
+	res.Transactions, err = ep.newTransactions(r.Context(), chainID, txList, include)
+	if err != nil {
+		log.Errorf("cannot retrieve transaction details: %v", err)
+		writeInternalServerError(w)
+		return responseGetTransactionsByHashes{}, 0, true
+	}
+
+	// none of the requested transactions exists
+	if len(res.Transactions) == 0 && len(res.NotFound) > 0 {
+		return res, http.StatusNotFound, false
 	}
 
+	return res, http.StatusOK, false
+}
+
+// newTransactions converts the stored transactions of the chain into their api representation, along with
+// the requested related data
+func (ep *EndPoint) newTransactions(ctx context.Context, chainID int64, txList []*models.Transaction,
+	include map[string]bool) ([]*Transaction, error) {
+	txHashes := make([]string, 0, len(txList))
 	for _, tx := range txList {
-		blockNumber := new(big.Int)
-		tx.BlockNumber.Int(blockNumber)
+		txHashes = append(txHashes, tx.TXHash)
+	}
+
+	blockHashes := make([]string, 0, len(txList))
+	for _, tx := range txList {
+		if tx.BlockHash.Valid && !slices.Contains(blockHashes, tx.BlockHash.String) {
+			blockHashes = append(blockHashes, tx.BlockHash.String)
+		}
+	}
+
+	var blockMap map[string]*models.Block
+	var transferMap map[string][]*models.TokenTransfer
+	var logMap map[string][]*models.TransactionLog
+	var traceMap map[string][]*models.TransactionTrace
+	if len(txList) > 0 {
+		var err error
+		if len(blockHashes) > 0 {
+			if blockMap, err = ep.ap.GetBlocks(ctx, chainID, blockHashes); err != nil {
+				return nil, err
+			}
+		}
+
+		if transferMap, err = ep.ap.GetTokenTransfers(ctx, chainID, txHashes); err != nil {
+			return nil, err
+		}
+
+		if include[includeLogs] {
+			if logMap, err = ep.ap.GetTransactionLogs(ctx, chainID, txHashes); err != nil {
+				return nil, err
+			}
+		}
+
+		if include[includeTraces] {
+			if traceMap, err = ep.ap.GetTransactionTraces(ctx, chainID, txHashes); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	head, finalized := ep.ap.ChainHead(chainID)
+	transactions := make([]*Transaction, 0, len(txList))
+	for _, tx := range txList {
+		transaction := newTransaction(tx, head, finalized)
+		if block, found := blockMap[tx.BlockHash.String]; found {
+			transaction.Timestamp = null.TimeFrom(block.Timestamp)
+		}
+		transaction.DecodedInput = newDecodedInput(ep.ap.DecodeInput(ctx, tx))
+		transaction.TokenTransfers = make([]*TokenTransfer, 0, len(transferMap[tx.TXHash]))
+		for _, transfer := range transferMap[tx.TXHash] {
+			transaction.TokenTransfers = append(transaction.TokenTransfers, newTokenTransfer(transfer))
+		}
+		for _, txLog := range logMap[tx.TXHash] {
+			transaction.Logs = append(transaction.Logs, newLog(txLog))
+		}
+		for _, trace := range traceMap[tx.TXHash] {
+			transaction.Traces = append(transaction.Traces, newTrace(trace))
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// parseInclude returns the related data requested with the include query parameter, either repeated
+// or comma separated
+func parseInclude(w http.ResponseWriter, r *http.Request) (map[string]bool, bool) {
+	var values []string
+	for _, value := range r.URL.Query()["include"] {
+		values = append(values, strings.Split(value, ",")...)
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(requestInclude{Include: values}); err != nil {
+		log.Errorf("cannot validate include query parameter: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return nil, true
+	}
+
+	include := make(map[string]bool, len(values))
+	for _, value := range values {
+		include[value] = true
+	}
+	return include, false
+}
+
+// parsePeriod returns the period of the since/until query parameters, either RFC 3339 time or unix seconds
+func parsePeriod(w http.ResponseWriter, r *http.Request) (store.Period, bool) {
+	var period requestPeriod
+	var err error
+	query := r.URL.Query()
+	if period.Since, err = parseTime(query.Get("since")); err == nil {
+		period.Until, err = parseTime(query.Get("until"))
+	}
+	if err == nil {
+		validate := validator.New()
+		err = validate.Struct(period)
+	}
+	if err != nil {
+		log.Errorf("cannot validate since/until query parameters: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return store.Period{}, true
+	}
+
+	return store.Period{Since: period.Since, Until: period.Until}, false
+}
+
+// parseFilter returns the filter of the query parameters, along with the period of since/until ones;
+// the addresses are compared in their checksum form, which is the stored one
+func parseFilter(w http.ResponseWriter, r *http.Request) (store.Filter, bool) {
+	period, done := parsePeriod(w, r)
+	if done {
+		return store.Filter{}, true
+	}
+
+	query := r.URL.Query()
+	request := requestFilter{
+		From:             query.Get("from"),
+		To:               query.Get("to"),
+		Contract:         query.Get("contract"),
+		Status:           query.Get("status"),
+		FromBlock:        query.Get("fromBlock"),
+		ToBlock:          query.Get("toBlock"),
+		MinValue:         query.Get("minValue"),
+		MaxValue:         query.Get("maxValue"),
+		ContractCreation: query.Get("contractCreation"),
+		Sort:             query.Get("sort"),
+	}
+	validate := validator.New()
+	filter, err := store.Filter{Period: period}, validate.Struct(request)
+	if err == nil {
+		err = request.fill(&filter)
+	}
+	if err != nil {
+		log.Errorf("cannot validate filter query parameters: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return store.Filter{}, true
+	}
+
+	return filter, false
+}
+
+// fill sets the validated conditions to the filter, the ranges must not be empty
+func (request *requestFilter) fill(filter *store.Filter) error {
+	checksum := func(address string) string {
+		if address == "" {
+			return ""
+		}
+		return common.HexToAddress(address).Hex()
+	}
+	filter.From, filter.To, filter.Contract = checksum(request.From), checksum(request.To), checksum(request.Contract)
+
+	if request.Status != "" {
+		status, _ := strconv.Atoi(request.Status)
+		filter.Status = &status
+	}
+	if request.ContractCreation != "" {
+		creation := request.ContractCreation == "true"
+		filter.ContractCreation = &creation
+	}
+	if request.Sort == sortValueDesc {
+		filter.Sort = store.SortValueDesc
+	}
+
+	if request.FromBlock != "" {
+		fromBlock, err := strconv.ParseUint(request.FromBlock, 10, 64)
+		if err != nil {
+			return err
+		}
+		filter.FromBlock = &fromBlock
+	}
+	if request.ToBlock != "" {
+		toBlock, err := strconv.ParseUint(request.ToBlock, 10, 64)
+		if err != nil {
+			return err
+		}
+		filter.ToBlock = &toBlock
+	}
+	if filter.FromBlock != nil && filter.ToBlock != nil && *filter.FromBlock > *filter.ToBlock {
+		return fmt.Errorf("fromBlock %d is after toBlock %d", *filter.FromBlock, *filter.ToBlock)
+	}
+
+	if request.MinValue != "" {
+		filter.MinValue, _ = new(big.Int).SetString(request.MinValue, 10)
+	}
+	if request.MaxValue != "" {
+		filter.MaxValue, _ = new(big.Int).SetString(request.MaxValue, 10)
+	}
+	if filter.MinValue != nil && filter.MaxValue != nil && filter.MinValue.Cmp(filter.MaxValue) > 0 {
+		return fmt.Errorf("minValue %s is above maxValue %s", filter.MinValue, filter.MaxValue)
+	}
+	return nil
+}
+
+// parsePage returns the page of the limit/cursor query parameters, or whether all transactions are streamed
+// instead, which excludes the paging
+func parsePage(w http.ResponseWriter, r *http.Request) (store.Page, bool, bool) {
+	var page requestPage
+	var err error
+	query := r.URL.Query()
+	page.Cursor = query.Get("cursor")
+	if value := query.Get("limit"); value != "" {
+		page.Limit, err = strconv.Atoi(value)
+	}
+	if value := query.Get("stream"); err == nil && value != "" {
+		page.Stream, err = strconv.ParseBool(value)
+	}
+	if err == nil {
+		validate := validator.New()
+		err = validate.Struct(page)
+	}
+
+	var cursor *store.Cursor
+	if err == nil && page.Cursor != "" {
+		cursor, err = parseCursor(page.Cursor)
+		if page.Limit == 0 {
+			page.Limit = defaultPageLimit
+		}
+	}
+	if err != nil {
+		log.Errorf("cannot validate limit/cursor/stream query parameters: %v", err)
+		writeJSONError(w, http.StatusUnprocessableEntity, ErrValidationFailed)
+		return store.Page{}, false, true
+	}
+
+	return store.Page{Limit: page.Limit, Cursor: cursor}, page.Stream, false
+}
+
+// encodeCursor returns the opaque cursor, the empty one for nil
+func encodeCursor(cursor *store.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	value := pageCursor{Value: cursor.Value, TXHash: cursor.TXHash}
+	if cursor.BlockNumber.Big != nil {
+		value.BlockNumber = cursor.BlockNumber.String()
+	}
+	encoded, _ := json.Marshal(value)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// parseCursor decodes the opaque cursor returned along with the previous page
+func parseCursor(value string) (*store.Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor pageCursor
+	if err = json.Unmarshal(decoded, &cursor); err != nil {
+		return nil, err
+	}
+	validate := validator.New()
+	if err = validate.Struct(cursor); err != nil {
+		return nil, err
+	}
+
+	res := &store.Cursor{Value: cursor.Value, TXHash: cursor.TXHash}
+	if cursor.BlockNumber != "" {
+		blockNumber, ok := new(decimal.Big).SetString(cursor.BlockNumber)
+		if !ok {
+			return nil, fmt.Errorf("invalid block number of cursor: %s", cursor.BlockNumber)
+		}
+		res.BlockNumber = types.NewNullDecimal(blockNumber)
+	}
+	return res, nil
+}
 
-		res.Transactions = append(res.Transactions,
-			&Transaction{
-				Hash:            tx.TXHash,
-				Status:          tx.TXStatus,
-				BlockHash:       tx.BlockHash,
-				BlockNumber:     blockNumber,
-				From:            tx.FromAddress,
-				To:              tx.ToAddress,
-				ContractAddress: tx.ContractAddress,
-				LogsCount:       int(tx.LogsCount),
-				Input:           tx.Input,
-				Value:           tx.Value,
-			},
-		)
-	}
-	return res, false
+// parseTime parses RFC 3339 time or unix seconds, the empty value stands for the zero time
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// resolveChain returns the chain of the {chain} path parameter, or the default chain for the routes without it
+func (ep *EndPoint) resolveChain(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	chainID, err := ep.ap.ResolveChain(mux.Vars(r)["chain"])
+	if err != nil {
+		log.Errorf("cannot resolve chain: %v", err)
+		writeJSONError(w, http.StatusNotFound, app.ErrUnknownChain)
+		return 0, true
+	}
+	return chainID, false
 }
 
 func createToken(jwtSecret string, userID int) (string, error) {
@@ -5,13 +5,20 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/app"
+	"ethereum-fetcher/internal/network"
+	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg/models"
 
 	"github.com/ericlagergren/decimal"
@@ -24,6 +31,8 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/types"
 
 	servicemocks "ethereum-fetcher/internal/app/mocks"
+	decodermocks "ethereum-fetcher/internal/decoder/mocks"
+	storagemocks "ethereum-fetcher/internal/store/mocks"
 )
 
 // This test suite is added to satisfy the following requirement:
@@ -64,9 +73,10 @@ func (s *EndpointTestSuite) TestGetTransactionsByRLPEndpoints() {
 	}
 
 	tests := []struct {
-		name string
-		exp  expected
-		args string
+		name  string
+		exp   expected
+		args  string
+		chain string
 	}{
 		{
 			name: "with provided valid rlp encoded list, it returns OK",
@@ -85,6 +95,41 @@ func (s *EndpointTestSuite) TestGetTransactionsByRLPEndpoints() {
 			exp:  expected{statusCode: http.StatusOK, err: nil},
 			args: `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
 		},
+		{
+			name:  "with provided valid rlp encoded list of single element for chain by name, it returns OK",
+			exp:   expected{statusCode: http.StatusOK, err: nil},
+			args:  `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
+			chain: "sepolia",
+		},
+		{
+			name:  "with provided chain without configured node, it returns NotFound",
+			exp:   expected{statusCode: http.StatusNotFound, err: nil},
+			args:  `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
+			chain: "mainnet",
+		},
+		{
+			name: "with provided hash unknown to the node, it returns NotFound",
+			exp: expected{statusCode: http.StatusNotFound,
+				err: &app.NotFoundError{TxHashes: []string{
+					"0x307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265",
+				}},
+			},
+			args: `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
+		},
+		{
+			name: "with unavailable ethereum node, it returns ServiceUnavailable",
+			exp: expected{statusCode: http.StatusServiceUnavailable,
+				err: fmt.Errorf("%w: too many requests", app.ErrNodeUnavailable),
+			},
+			args: `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
+		},
+		{
+			name: "with node data failing the verification, it returns BadGateway",
+			exp: expected{statusCode: http.StatusBadGateway,
+				err: fmt.Errorf("%w: receipts root mismatch", app.ErrVerificationFailed),
+			},
+			args: `f844b842307866633262336236646233386135316462336239636239356465323962373139646538646562393936333036323665346234623939646630353666666237663265`,
+		},
 		{
 			name: "with provided broken rlp encoded list, it returns UnprocessableEntity",
 			exp: expected{statusCode: http.StatusUnprocessableEntity, err: nil,
@@ -102,30 +147,51 @@ func (s *EndpointTestSuite) TestGetTransactionsByRLPEndpoints() {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/eth/"+tt.args, bytes.NewBufferString(""))
+			url := "http://127.0.0.1/lime/eth/" + tt.args
+			if tt.chain != "" {
+				url = "http://127.0.0.1/lime/" + tt.chain + "/eth/" + tt.args
+			}
+			request := httptest.NewRequest("GET", url, bytes.NewBufferString(""))
 			request.Header.Set("Content-Type", "application/json")
 			response := httptest.NewRecorder()
 
-			app := servicemocks.NewServiceProvider(s.T())
+			ap := servicemocks.NewServiceProvider(s.T())
 
 			var txList []*models.Transaction
 
 			if tt.exp.txHashes != nil {
 				txList = mockSetupTransactions(tt.exp.txHashes)
 			}
-			app.On("GetTransactionsByHashes", mock.AnythingOfType("*context.valueCtx"),
+			ap.On("ResolveChain", mock.MatchedBy(func(chain string) bool { return chain == "" || chain == "sepolia" })).
+				Return(int64(cmd.SepoliaChainID), nil).Maybe()
+			ap.On("ResolveChain", "mainnet").Return(int64(0), app.ErrUnknownChain).Maybe()
+			ap.On("GetTransactionsByHashes", mock.AnythingOfType("*context.valueCtx"), int64(cmd.SepoliaChainID),
 				mock.AnythingOfType("[]string"), mock.AnythingOfType("int")).
 				Return(txList, tt.exp.err).Maybe()
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockTokenTransfers(txList), nil).Maybe()
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockBlocks(txList), nil).Maybe()
 
-			ep := NewEndPoint(s.ctx, s.vp, app)
+			ep := NewEndPoint(s.ctx, s.vp, ap)
 
 			// prepare the /lime/eth/{rlphex} endpoint and call it
 			r := mux.NewRouter()
 			r.HandleFunc("/lime/eth/{rlphex}", ep.GetTransactionsByRLP)
+			r.HandleFunc("/lime/{chain}/eth/{rlphex}", ep.GetTransactionsByRLP)
 			r.ServeHTTP(response, request)
 
 			require.Equal(t, tt.exp.statusCode, response.Code)
 
+			if notFoundErr := new(app.NotFoundError); errors.As(tt.exp.err, &notFoundErr) {
+				resp := new(responseGetTransactionsByHashes)
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+				require.Empty(t, resp.Transactions)
+				require.Len(t, resp.NotFound, 1)
+			}
+
 			if tt.exp.statusCode == http.StatusOK {
 				require.NotEmpty(t, response.Body.String())
 
@@ -139,12 +205,702 @@ func (s *EndpointTestSuite) TestGetTransactionsByRLPEndpoints() {
 				// compare decoded RLPs to expected list
 				for i := range resp.Transactions {
 					require.Equal(t, txList[i].TXHash, resp.Transactions[i].Hash)
+					require.Equal(t, uint64(10), resp.Transactions[i].Confirmations)
+					require.True(t, resp.Transactions[i].Finalized)
+					require.Equal(t, "21000000000000000", resp.Transactions[i].Fee.String)
+					require.True(t, mockBlockTime.Equal(resp.Transactions[i].Timestamp.Time))
+					require.Len(t, resp.Transactions[i].TokenTransfers, 1)
+					require.Equal(t, txList[i].TXHash, resp.Transactions[i].TokenTransfers[0].Hash)
+				}
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestGetAllTransactionsPages() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{
+		"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+		"0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+	})
+	next := store.NewCursor(txList[0])
+	pending := &store.Cursor{Value: txList[1].Value, TXHash: txList[1].TXHash}
+
+	tests := []struct {
+		name       string
+		query      string
+		page       store.Page
+		next       *store.Cursor
+		statusCode int
+		wantNext   string
+	}{
+		{
+			name:       "with limit, it returns OK with the cursor of the next page",
+			query:      "?limit=1",
+			page:       store.Page{Limit: 1},
+			next:       next,
+			statusCode: http.StatusOK,
+			wantNext:   encodeCursor(next),
+		},
+		{
+			name:       "with cursor only, it returns OK with the page of default limit",
+			query:      "?cursor=" + encodeCursor(next),
+			page:       store.Page{Limit: defaultPageLimit, Cursor: next},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with cursor of pending transaction, it returns OK",
+			query:      "?limit=5&cursor=" + encodeCursor(pending),
+			page:       store.Page{Limit: 5, Cursor: pending},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with broken cursor, it returns UnprocessableEntity",
+			query:      "?cursor=eyJoIjoiMHgxMjMifQ",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with too big limit, it returns UnprocessableEntity",
+			query:      "?limit=1001",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with stream and limit, it returns UnprocessableEntity",
+			query:      "?stream=true&limit=1",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/all"+tt.query, bytes.NewBufferString(""))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("GetAllTransactions", mock.Anything, int64(cmd.SepoliaChainID), store.Filter{}, tt.page).
+				Return(txList[:1], tt.next, nil).Maybe()
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockBlocks(txList), nil).Maybe()
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/all", ep.GetAllTransactions)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			resp := new(responseGetAllTransactions)
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+			require.Len(t, resp.Transactions, 1)
+			require.Equal(t, tt.wantNext, resp.NextCursor)
+			if tt.wantNext != "" {
+				cursor, err := parseCursor(resp.NextCursor)
+				require.NoError(t, err)
+				require.Equal(t, txList[0].TXHash, cursor.TXHash)
+				require.Equal(t, txList[0].BlockNumber.String(), cursor.BlockNumber.String())
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestGetAllTransactionsFilter() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{
+		"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+	})
+	status, creation := 1, false
+	fromBlock, toBlock := uint64(5703000), uint64(5703601)
+
+	tests := []struct {
+		name       string
+		query      string
+		filter     store.Filter
+		statusCode int
+	}{
+		{
+			name:       "with addresses, it returns OK with the checksum addresses",
+			query:      "?from=0x1fc35b79fb11ea7d4532da128dfa9db573c51b09&to=0xAa449E0226B45D2044B1f721D04001fDe02ABb08",
+			filter:     store.Filter{From: txList[0].FromAddress, To: txList[0].ToAddress.String},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:  "with status, block range and contract creation, it returns OK",
+			query: "?status=1&fromBlock=5703000&toBlock=5703601&contractCreation=false",
+			filter: store.Filter{Status: &status, FromBlock: &fromBlock, ToBlock: &toBlock,
+				ContractCreation: &creation},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:  "with value range and sort by value, it returns OK",
+			query: "?minValue=1&maxValue=1000000000000000000000000&sort=-value",
+			filter: store.Filter{MinValue: big.NewInt(1), MaxValue: new(big.Int).Mul(big.NewInt(1e12), big.NewInt(1e12)),
+				Sort: store.SortValueDesc},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with sort by block number and period, it returns OK",
+			query:      "?sort=blockNumber&since=1714089600",
+			filter:     store.Filter{Period: store.Period{Since: mockBlockTime.Add(time.Hour)}},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with broken address, it returns UnprocessableEntity",
+			query:      "?contract=0x1234",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with unknown status, it returns UnprocessableEntity",
+			query:      "?status=2",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with empty block range, it returns UnprocessableEntity",
+			query:      "?fromBlock=5703601&toBlock=5703000",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with negative value, it returns UnprocessableEntity",
+			query:      "?minValue=-1",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with unknown sort, it returns UnprocessableEntity",
+			query:      "?sort=value",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/all"+tt.query, bytes.NewBufferString(""))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("GetAllTransactions", mock.Anything, int64(cmd.SepoliaChainID), tt.filter, store.Page{}).
+				Return(txList, (*store.Cursor)(nil), nil).Maybe()
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockBlocks(txList), nil).Maybe()
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/all", ep.GetAllTransactions)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestStreamTransactions() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{
+		"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+		"0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+		"0x33333f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df73333",
+	})
+
+	tests := []struct {
+		name       string
+		chunks     [][]*models.Transaction
+		err        error
+		statusCode int
+		want       int
+	}{
+		{
+			name:       "with chunks, it returns OK with all transactions",
+			chunks:     [][]*models.Transaction{txList[:2], txList[2:]},
+			statusCode: http.StatusOK,
+			want:       3,
+		},
+		{
+			name:       "with nothing to stream, it returns OK with no transactions",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with db error before the first chunk, it returns InternalServerError",
+			err:        errors.New("cannot declare cursor"),
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/my?stream=true", bytes.NewBufferString(""))
+			request = request.WithContext(context.WithValue(request.Context(), userIDKey, 1))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("StreamMyTransactions", mock.Anything, int64(cmd.SepoliaChainID), 1, store.Filter{}, mock.Anything).
+				Return(func(_ context.Context, _ int64, _ int, _ store.Filter, fn store.StreamFunc) error {
+					for _, chunk := range tt.chunks {
+						if err := fn(chunk); err != nil {
+							return err
+						}
+					}
+					return tt.err
+				})
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockBlocks(txList), nil).Maybe()
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/my", ep.GetMyTransactions)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			resp := new(responseGetAllTransactions)
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+			require.Len(t, resp.Transactions, tt.want)
+			for i, tx := range resp.Transactions {
+				require.Equal(t, txList[i].TXHash, tx.Hash)
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestGetAllTransactionsInclude() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{
+		"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+		"0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+	})
+	logMap := map[string][]*models.TransactionLog{
+		txList[1].TXHash: {{
+			ChainID:  cmd.SepoliaChainID,
+			TXHash:   txList[1].TXHash,
+			LogIndex: 3,
+			Address:  "0x4c16D8C078eF6B56700C1BE19a336915962df072",
+			Topics:   types.StringArray{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+			Data:     "0x",
+		}},
+	}
+	traceMap := map[string][]*models.TransactionTrace{
+		txList[0].TXHash: {
+			{ChainID: cmd.SepoliaChainID, TXHash: txList[0].TXHash, TraceIndex: 0, CallType: "CALL", Value: "0",
+				FromAddress: "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09", Depth: 0},
+			{ChainID: cmd.SepoliaChainID, TXHash: txList[0].TXHash, TraceIndex: 1, CallType: "CALL", Value: "1000",
+				FromAddress: "0xAa449E0226B45D2044B1f721D04001fDe02ABb08", Depth: 1},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		period     store.Period
+		statusCode int
+		wantLogs   []int
+		wantTraces []int
+	}{
+		{
+			name:       "without include, it returns OK without logs",
+			statusCode: http.StatusOK,
+			wantLogs:   []int{0, 0},
+		},
+		{
+			name:       "with include of logs, it returns OK with the logs of each transaction",
+			query:      "?include=logs",
+			statusCode: http.StatusOK,
+			wantLogs:   []int{0, 1},
+		},
+		{
+			name:       "with include of logs and traces, it returns OK with the call frames of each transaction",
+			query:      "?include=logs&include=traces",
+			statusCode: http.StatusOK,
+			wantLogs:   []int{0, 1},
+			wantTraces: []int{2, 0},
+		},
+		{
+			name:       "with include of unknown data, it returns UnprocessableEntity",
+			query:      "?include=logs,balances",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with since and until time, it returns OK",
+			query:      "?since=2024-04-25T00:00:00Z&until=1714089600",
+			period:     store.Period{Since: mockBlockTime.Add(-time.Hour * 23), Until: mockBlockTime.Add(time.Hour)},
+			statusCode: http.StatusOK,
+			wantLogs:   []int{0, 0},
+		},
+		{
+			name:       "with until before since, it returns UnprocessableEntity",
+			query:      "?since=1714089600&until=2024-04-25T00:00:00Z",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with broken since time, it returns UnprocessableEntity",
+			query:      "?since=yesterday",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/all"+tt.query, bytes.NewBufferString(""))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("GetAllTransactions", mock.Anything, int64(cmd.SepoliaChainID), store.Filter{Period: tt.period}, store.Page{}).
+				Return(txList, (*store.Cursor)(nil), nil).Maybe()
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(mockBlocks(txList), nil).Maybe()
+			ap.On("GetTransactionLogs", mock.Anything, int64(cmd.SepoliaChainID), []string{txList[0].TXHash, txList[1].TXHash}).
+				Return(logMap, nil).Maybe()
+			ap.On("GetTransactionTraces", mock.Anything, int64(cmd.SepoliaChainID), []string{txList[0].TXHash, txList[1].TXHash}).
+				Return(traceMap, nil).Maybe()
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/all", ep.GetAllTransactions)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			resp := new(responseGetAllTransactions)
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+			require.Len(t, resp.Transactions, len(tt.wantLogs))
+			for i, wantLogs := range tt.wantLogs {
+				require.Len(t, resp.Transactions[i].Logs, wantLogs)
+			}
+			if tt.wantLogs[1] > 0 {
+				require.Equal(t, 3, resp.Transactions[1].Logs[0].LogIndex)
+			}
+			for i, wantTraces := range tt.wantTraces {
+				require.Len(t, resp.Transactions[i].Traces, wantTraces)
+			}
+			if len(tt.wantTraces) == 0 {
+				for _, tx := range resp.Transactions {
+					require.Empty(t, tx.Traces, "the traces must be returned only on request")
+				}
+			} else {
+				require.Equal(t, "1000", resp.Transactions[0].Traces[1].Value)
+				require.Equal(t, 1, resp.Transactions[0].Traces[1].Depth)
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestUploadABIEndpoints() {
+	t := s.T()
+
+	const address = "0x4c16D8C078eF6B56700C1BE19a336915962df072"
+
+	tests := []struct {
+		name       string
+		address    string
+		errApp     error
+		statusCode int
+	}{
+		{
+			name:       "with provided valid abi, it returns OK with its methods",
+			address:    address,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with provided broken abi, it returns UnprocessableEntity",
+			address:    address,
+			errApp:     fmt.Errorf("%w: unexpected end of JSON input", app.ErrInvalidABI),
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with provided invalid address, it returns UnprocessableEntity",
+			address:    "0x4c16",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "http://127.0.0.1/lime/abi/"+tt.address,
+				bytes.NewBufferString(`[{"type":"function","name":"transfer"}]`))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("UploadABI", mock.Anything, int64(cmd.SepoliaChainID), tt.address, mock.AnythingOfType("string")).
+				Return([]string{"transfer()"}, tt.errApp).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/abi/{address}", ep.UploadABI)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode == http.StatusOK {
+				resp := new(responseUploadABI)
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+				require.Equal(t, []string{"transfer()"}, resp.Methods)
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestWatchAddressEndpoints() {
+	t := s.T()
+
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+	}{
+		{
+			name:       "with provided valid address, it returns OK with the lower case address",
+			body:       `{"address":"0x4c16D8C078eF6B56700C1BE19a336915962df072"}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with provided invalid address, it returns UnprocessableEntity",
+			body:       `{"address":"0x4c16"}`,
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with provided broken body, it returns BadRequest",
+			body:       `{"address":`,
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "http://127.0.0.1/lime/watch", bytes.NewBufferString(tt.body))
+			request = request.WithContext(context.WithValue(request.Context(), userIDKey, 7))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			ap.On("WatchAddress", mock.Anything, int64(cmd.SepoliaChainID), 7, "0x4c16D8C078eF6B56700C1BE19a336915962df072").
+				Return(nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/watch", ep.WatchAddress)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode == http.StatusOK {
+				resp := new(responseWatchAddress)
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+				require.Equal(t, "0x4c16d8c078ef6b56700c1be19a336915962df072", resp.Address)
+				require.Equal(t, int64(cmd.SepoliaChainID), resp.ChainID)
+			}
+		})
+	}
+}
+
+func (s *EndpointTestSuite) TestGetBlockTransactionsEndpoints() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{
+		"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+		"0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+	})
+
+	tests := []struct {
+		name       string
+		number     string
+		err        error
+		statusCode int
+	}{
+		{
+			name:       "with provided known block, it returns OK with its transactions",
+			number:     "5703601",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with provided block number out of range, it returns UnprocessableEntity",
+			number:     "18446744073709551616",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with provided non numeric block number, it returns UnprocessableEntity",
+			number:     "latest",
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with block beyond the head, it returns NotFound",
+			number:     "5703601",
+			err:        fmt.Errorf("%w: failed to fetch block 5703601", app.ErrBlockNotFound),
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "with unavailable node, it returns ServiceUnavailable",
+			number:     "5703601",
+			err:        app.ErrNodeUnavailable,
+			statusCode: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "http://127.0.0.1/lime/block/"+tt.number, nil)
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			if tt.err != nil {
+				ap.On("GetBlockTransactions", mock.Anything, int64(cmd.SepoliaChainID), uint64(5703601)).
+					Return(nil, tt.err)
+			} else {
+				ap.On("GetBlockTransactions", mock.Anything, int64(cmd.SepoliaChainID), uint64(5703601)).
+					Return(txList, nil).Maybe()
+			}
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.Anything).
+				Return(map[string]*models.Block{}, nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), []string{txList[0].TXHash, txList[1].TXHash}).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/block/{number}", ep.GetBlockTransactions)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.statusCode == http.StatusOK {
+				resp := new(responseGetBlockTransactions)
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+				require.Len(t, resp.Transactions, len(txList))
+				for i, tx := range resp.Transactions {
+					require.Equal(t, txList[i].TXHash, tx.Hash, "the order of the block must be kept")
 				}
 			}
 		})
 	}
 }
 
+func (s *EndpointTestSuite) TestBroadcastTransactionEndpoints() {
+	t := s.T()
+
+	txList := mockSetupTransactions([]string{"0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111"})
+	pendingTx := &models.Transaction{
+		ChainID:     cmd.SepoliaChainID,
+		TXHash:      txList[0].TXHash,
+		FromAddress: txList[0].FromAddress,
+		ToAddress:   txList[0].ToAddress,
+		Input:       "0x",
+		Value:       "500",
+		Pending:     true,
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		statusCode int
+	}{
+		{
+			name:       "with provided valid raw transaction, it returns OK with the pending transaction",
+			body:       `{"rawTransaction":"0x02f86f"}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with provided raw transaction without prefix, it returns OK with the pending transaction",
+			body:       `{"rawTransaction":"02F86F"}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with provided raw transaction of odd length, it returns UnprocessableEntity",
+			body:       `{"rawTransaction":"0x02f86"}`,
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with provided non hex raw transaction, it returns UnprocessableEntity",
+			body:       `{"rawTransaction":"signed"}`,
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with provided broken body, it returns BadRequest",
+			body:       `{"rawTransaction":`,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "with transaction rejected by the node, it returns UnprocessableEntity",
+			body:       `{"rawTransaction":"0x02f86f"}`,
+			err:        fmt.Errorf("%w: nonce too low", app.ErrInvalidTransaction),
+			statusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "with unavailable node, it returns ServiceUnavailable",
+			body:       `{"rawTransaction":"0x02f86f"}`,
+			err:        app.ErrNodeUnavailable,
+			statusCode: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "http://127.0.0.1/lime/tx", bytes.NewBufferString(tt.body))
+			request = request.WithContext(context.WithValue(request.Context(), userIDKey, 7))
+			response := httptest.NewRecorder()
+
+			ap := servicemocks.NewServiceProvider(s.T())
+			ap.On("ResolveChain", "").Return(int64(cmd.SepoliaChainID), nil)
+			if tt.err != nil {
+				ap.On("BroadcastTransaction", mock.Anything, int64(cmd.SepoliaChainID), []byte{0x02, 0xf8, 0x6f}, 7).
+					Return(nil, tt.err)
+			} else {
+				ap.On("BroadcastTransaction", mock.Anything, int64(cmd.SepoliaChainID), []byte{0x02, 0xf8, 0x6f}, 7).
+					Return(pendingTx, nil).Maybe()
+			}
+			ap.On("ChainHead", int64(cmd.SepoliaChainID)).Return(uint64(5703610), uint64(5703601)).Maybe()
+			ap.On("DecodeInput", mock.Anything, mock.Anything).Return(nil).Maybe()
+			ap.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), []string{pendingTx.TXHash}).
+				Return(map[string][]*models.TokenTransfer{}, nil).Maybe()
+
+			ep := NewEndPoint(s.ctx, s.vp, ap)
+
+			r := mux.NewRouter()
+			r.HandleFunc("/lime/tx", ep.BroadcastTransaction)
+			r.ServeHTTP(response, request)
+
+			require.Equal(t, tt.statusCode, response.Code)
+			if tt.err != nil {
+				require.Contains(t, response.Body.String(), tt.err.Error(), "the reason must be reported")
+			}
+			if tt.statusCode == http.StatusOK {
+				resp := new(Transaction)
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), resp))
+				require.Equal(t, pendingTx.TXHash, resp.Hash)
+				require.True(t, resp.Pending)
+				require.False(t, resp.Fee.Valid, "the fee is not known while pending")
+			}
+		})
+	}
+}
+
 func (s *EndpointTestSuite) TestAuthenticateEndpoints() {
 	t := s.T()
 
@@ -214,7 +970,7 @@ func (s *EndpointTestSuite) TestAuthenticateEndpoints() {
 			}
 
 			app := servicemocks.NewServiceProvider(s.T())
-			app.On("GetUser", mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			app.On("GetUser", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
 				Return(tt.exp.user, tt.exp.err).Maybe()
 
 			ep := NewEndPoint(s.ctx, s.vp, app)
@@ -265,6 +1021,23 @@ func (s *EndpointTestSuite) TestAuthenticateEndpoints() {
 	}
 }
 
+func (s *EndpointTestSuite) TestDebugVarsEndpoints() {
+	r := s.Require()
+
+	router := mux.NewRouter()
+	NewEndPoint(s.ctx, s.vp, servicemocks.NewServiceProvider(s.T())).Register(router)
+
+	// the metrics leak the command line and the memory stats, so they are not served by the public api
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest("GET", "http://127.0.0.1/debug/vars", nil))
+	r.Equal(http.StatusNotImplemented, response.Code)
+
+	response = httptest.NewRecorder()
+	NewAdminRouter().ServeHTTP(response, httptest.NewRequest("GET", "http://127.0.0.1/debug/vars", nil))
+	r.Equal(http.StatusOK, response.Code)
+	r.Contains(response.Body.String(), `"memstats"`)
+}
+
 type mockReadCloser struct {
 	io.ReadCloser
 }
@@ -273,28 +1046,140 @@ func (m mockReadCloser) Read(_ []byte) (n int, err error) {
 	return 0, errors.New("broken pipe")
 }
 
+// TestGetTransactionsByHashesReplayed runs the whole fetch of /lime/eth - the service and the ethereum node
+// provider - against the fake node, replaying the synthetic fixtures written by testdata/fixtures/generate.go, so no
+// network access is needed
+func (s *EndpointTestSuite) TestGetTransactionsByHashesReplayed() {
+	t := s.T()
+	r := s.Require()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	vp := cmd.NewViper()
+	vp.Set(cmd.NodeReplayDir, "testdata/fixtures")
+	vp.Set(cmd.NodeProbeInterval, "0s")
+	// the fixtures are consistent with the roots and the hash of their block, so they pass the verification
+	vp.Set(cmd.NodeVerifyReceipts, true)
+	chains := network.NewChains(ctx, vp)
+
+	// the storage keeps the fetched transactions in memory, so their blocks are looked up afterwards
+	var mu sync.Mutex
+	blocks := make(map[string]*models.Block)
+	st := storagemocks.NewStorageProvider(t)
+	st.On("GetTransactionsByHashes", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).Return(nil, nil)
+	st.On("InsertTransactions", mock.Anything, mock.AnythingOfType("[]*store.TxRecord"), 0).
+		Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, record := range args.Get(1).([]*store.TxRecord) {
+				blocks[record.Block.BlockHash] = record.Block
+			}
+		}).Return(nil)
+	st.On("GetBlocks", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).
+		Return(func(_ context.Context, _ int64, blockHashes []string) ([]*models.Block, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var blockList []*models.Block
+			for _, blockHash := range blockHashes {
+				blockList = append(blockList, blocks[blockHash])
+			}
+			return blockList, nil
+		})
+	st.On("GetTokenTransfers", mock.Anything, int64(cmd.SepoliaChainID), mock.AnythingOfType("[]string")).Return(nil, nil)
+
+	dec := decodermocks.NewInputDecoder(t)
+	dec.On("DecodeInput", mock.Anything, int64(cmd.SepoliaChainID), mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	ep := NewEndPoint(ctx, s.vp, app.NewService(ctx, vp, st, chains, dec))
+	router := mux.NewRouter()
+	ep.Register(router)
+
+	txHashes := []string{
+		"0x8868101b3ade9e86e945df0761095c6f1f4d450cc6509d1d61292aab4df864b2",
+		"0x968ed56089f2966951dfdbf8369a0836715b42febc61496ac2a2e3cfd51aa097",
+	}
+	unknown := "0x44443f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df74444"
+
+	request := httptest.NewRequest("GET", fmt.Sprintf("http://127.0.0.1/lime/eth?transactionHashes=%s"+
+		"&transactionHashes=%s&transactionHashes=%s", txHashes[0], unknown, txHashes[1]), bytes.NewBufferString(""))
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	r.Equal(http.StatusOK, response.Code, response.Body.String())
+
+	resp := new(responseGetTransactionsByHashes)
+	r.NoError(json.Unmarshal(response.Body.Bytes(), resp))
+	r.Equal([]string{unknown}, resp.NotFound)
+	r.Len(resp.Transactions, len(txHashes))
+	for i, tx := range resp.Transactions {
+		r.Equal(txHashes[i], tx.Hash)
+		r.Equal("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", tx.From)
+		r.Equal("0xAa449E0226B45D2044B1f721D04001fDe02ABb08", tx.To.String)
+		r.Equal("500", tx.Value)
+		r.Equal(int64(5703601), tx.BlockNumber.Int64())
+		r.Equal("21000000", tx.Fee.String)
+		r.Equal(int64(1714000000), tx.Timestamp.Time.Unix())
+		r.True(tx.Verified)
+	}
+}
+
 func mockSetupTransactions(txHashes []string) []*models.Transaction {
 	txList := make([]*models.Transaction, 0, len(txHashes))
 	for _, txHash := range txHashes {
 		tx := &models.Transaction{
 			TXHash:    txHash,
-			TXStatus:  1,
-			BlockHash: "0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577",
-			BlockNumber: types.Decimal{
+			TXStatus:  null.IntFrom(1),
+			BlockHash: null.StringFrom("0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577"),
+			BlockNumber: types.NullDecimal{
 				Big: decimal.New(5703601, 0),
 			},
-			FromAddress:     "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
-			ToAddress:       null.StringFrom("0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
-			ContractAddress: null.String{},
-			LogsCount:       0,
-			Input:           "0x",
-			Value:           "500000000000000000",
+			FromAddress:       "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
+			ToAddress:         null.StringFrom("0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
+			ContractAddress:   null.String{},
+			LogsCount:         0,
+			Input:             "0x",
+			Value:             "500000000000000000",
+			GasUsed:           null.Int64From(21000),
+			EffectiveGasPrice: null.StringFrom("1000000000000"),
 		}
 		txList = append(txList, tx)
 	}
 	return txList
 }
 
+// mockBlockTime is the timestamp of the block, where all the mocked transactions are mined
+var mockBlockTime = time.Date(2024, 4, 25, 23, 0, 0, 0, time.UTC)
+
+func mockBlocks(txList []*models.Transaction) map[string]*models.Block {
+	blockMap := make(map[string]*models.Block, len(txList))
+	for _, tx := range txList {
+		blockMap[tx.BlockHash.String] = &models.Block{
+			ChainID:     cmd.SepoliaChainID,
+			BlockHash:   tx.BlockHash.String,
+			BlockNumber: types.NewDecimal(tx.BlockNumber.Big),
+			Timestamp:   mockBlockTime,
+		}
+	}
+	return blockMap
+}
+
+func mockTokenTransfers(txList []*models.Transaction) map[string][]*models.TokenTransfer {
+	transferMap := make(map[string][]*models.TokenTransfer, len(txList))
+	for _, tx := range txList {
+		transferMap[tx.TXHash] = []*models.TokenTransfer{{
+			ChainID:      cmd.SepoliaChainID,
+			TXHash:       tx.TXHash,
+			Standard:     "erc20",
+			TokenAddress: "0x4c16D8C078eF6B56700C1BE19a336915962df072",
+			FromAddress:  "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
+			ToAddress:    "0xAa449E0226B45D2044B1f721D04001fDe02ABb08",
+			Amount:       "1000",
+		}}
+	}
+	return transferMap
+}
+
 func TestEndpointTestSuite(t *testing.T) {
 	suite.Run(t, new(EndpointTestSuite))
 }
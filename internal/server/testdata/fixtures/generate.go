@@ -0,0 +1,213 @@
+//go:build ignore
+
+// generate writes the synthetic sepolia fixtures replayed by the end-to-end test of /lime/eth - two signed
+// transactions, mined in a block assembled around them, along with their receipts; the transactions, the
+// receipts roots and the hash of the block match its content, only the fields depending on the rest of the chain
+// (e.g. the state root, the parent and the miner) are made up.
+//
+// Run from this directory:
+//
+//	go run generate.go
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const chainID = 11155111
+
+// the transfers of 500 wei, signed on sepolia by a throwaway key, with the consecutive nonces
+var rawTransactions = []string{
+	"0x02f86983aa36a701018203e882520894aa449e0226b45d2044b1f721d04001fde02abb088201f480c001a035c045deb7c8b0cae" +
+		"327682285269f56f22649bebd72c5350664915b38584905a003b661471086f65f4a1e6ba098bf11e33dca0335f8f2fea9d5d347" +
+		"48bcfa42c4",
+	"0x02f86983aa36a702018203e882520894aa449e0226b45d2044b1f721d04001fde02abb088201f480c001a0f9a4e840c93d6b0c2" +
+		"9bb85aba4657ab88449393094881b0767fc437bd95674bea03a1362c0a7afba2f372224877490ec5179dca3834a44a0f564084" +
+		"1016fdfd996",
+}
+
+// unknownHash is the transaction not known by the node
+var unknownHash = common.HexToHash("0x44443f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df74444")
+
+// sepoliaTotalDifficulty is the terminal total difficulty of sepolia, it does not grow after the merge
+var sepoliaTotalDifficulty = hexutil.MustDecodeBig("0x3c6568f12e8000")
+
+func main() {
+	signer := types.LatestSignerForChainID(big.NewInt(chainID))
+	baseFee := big.NewInt(999)
+
+	txs := make(types.Transactions, 0, len(rawTransactions))
+	receipts := make(types.Receipts, 0, len(rawTransactions))
+	var cumulativeGasUsed uint64
+	for i, raw := range rawTransactions {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(hexutil.MustDecode(raw)); err != nil {
+			log.Fatalf("invalid transaction %d: %v", i, err)
+		}
+		cumulativeGasUsed += tx.Gas()
+		txs = append(txs, tx)
+		receipts = append(receipts, &types.Receipt{
+			Type:              tx.Type(),
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: cumulativeGasUsed,
+			Logs:              []*types.Log{},
+			TxHash:            tx.Hash(),
+			GasUsed:           tx.Gas(),
+			EffectiveGasPrice: effectiveGasPrice(tx, baseFee),
+			TransactionIndex:  uint(i),
+		})
+	}
+
+	header := &types.Header{
+		ParentHash:       madeUpHash("parent"),
+		UncleHash:        types.EmptyUncleHash,
+		Coinbase:         common.BytesToAddress(madeUpHash("miner").Bytes()),
+		Root:             madeUpHash("state"),
+		Difficulty:       common.Big0,
+		Number:           big.NewInt(5703601),
+		GasLimit:         30_000_000,
+		GasUsed:          cumulativeGasUsed,
+		Time:             1714000000,
+		Extra:            []byte{},
+		MixDigest:        madeUpHash("prevrandao"),
+		BaseFee:          baseFee,
+		BlobGasUsed:      new(uint64),
+		ExcessBlobGas:    new(uint64),
+		ParentBeaconRoot: ptr(madeUpHash("beacon")),
+	}
+	// the transactions root, the receipts root, the logs bloom and the withdrawals root are derived from the body
+	block := types.NewBlock(header, &types.Body{Transactions: txs, Withdrawals: []*types.Withdrawal{}}, receipts,
+		trie.NewStackTrie(nil))
+	for _, receipt := range receipts {
+		receipt.BlockHash = block.Hash()
+		receipt.BlockNumber = block.Number()
+	}
+
+	txHashes := make([]common.Hash, 0, len(txs))
+	rpcTxs := make([]map[string]any, 0, len(txs))
+	rpcReceipts := make([]map[string]any, 0, len(receipts))
+	for i, tx := range txs {
+		txHashes = append(txHashes, tx.Hash())
+		rpcTxs = append(rpcTxs, rpcTransaction(signer, block, tx, i))
+		rpcReceipts = append(rpcReceipts, rpcReceipt(signer, tx, receipts[i]))
+		write("eth_getTransactionByHash", []any{tx.Hash()}, rpcTxs[i])
+		write("eth_getTransactionReceipt", []any{tx.Hash()}, rpcReceipts[i])
+	}
+	write("eth_getTransactionByHash", []any{unknownHash}, nil)
+	write("eth_getTransactionReceipt", []any{unknownHash}, nil)
+	write("eth_getBlockByHash", []any{block.Hash(), false}, rpcBlock(block, txHashes))
+	// the transactions of the same block are fetched along with the block body and all of its receipts
+	write("eth_getBlockByHash", []any{block.Hash(), true}, rpcBlock(block, rpcTxs))
+	write("eth_getBlockReceipts", []any{block.Hash()}, rpcReceipts)
+	write("eth_blockNumber", []any{}, hexutil.Uint64(block.NumberU64()))
+}
+
+// rpcBlock is the block json of the node, along with the provided transactions
+func rpcBlock(block *types.Block, txs any) map[string]any {
+	fields := toFields(block.Header())
+	fields["size"] = hexutil.Uint64(block.Size())
+	fields["totalDifficulty"] = (*hexutil.Big)(sepoliaTotalDifficulty)
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	fields["withdrawals"] = block.Withdrawals()
+	return fields
+}
+
+// rpcTransaction is the transaction json of the node, for the transaction mined at the position of the block
+func rpcTransaction(signer types.Signer, block *types.Block, tx *types.Transaction, index int) map[string]any {
+	fields := toFields(tx)
+	fields["blockHash"] = block.Hash()
+	fields["blockNumber"] = (*hexutil.Big)(block.Number())
+	fields["from"] = sender(signer, tx)
+	fields["gasPrice"] = (*hexutil.Big)(effectiveGasPrice(tx, block.BaseFee()))
+	fields["transactionIndex"] = hexutil.Uint64(index)
+	return fields
+}
+
+// rpcReceipt is the receipt json of the node
+func rpcReceipt(signer types.Signer, tx *types.Transaction, receipt *types.Receipt) map[string]any {
+	fields := toFields(receipt)
+	delete(fields, "root")
+	fields["contractAddress"] = nil
+	fields["from"] = sender(signer, tx)
+	fields["to"] = tx.To()
+	return fields
+}
+
+func effectiveGasPrice(tx *types.Transaction, baseFee *big.Int) *big.Int {
+	return new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+}
+
+func sender(signer types.Signer, tx *types.Transaction) common.Address {
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		log.Fatalf("cannot recover sender of %s: %v", tx.Hash().Hex(), err)
+	}
+	return from
+}
+
+// madeUpHash is the hash of the field that cannot be derived from the fixtures, e.g. the state root
+func madeUpHash(field string) common.Hash {
+	return crypto.Keccak256Hash([]byte("ethereum-fetcher fixtures: " + field))
+}
+
+// toFields converts the value into its json fields, dropping the ones without value
+func toFields(value any) map[string]any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fields := make(map[string]any)
+	if err = json.Unmarshal(data, &fields); err != nil {
+		log.Fatal(err)
+	}
+	for name, field := range fields {
+		if field == nil {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
+
+// write saves the call the same way, as recorded by NODE_RECORD_DIR
+func write(method string, args []any, result any) {
+	params, err := json.Marshal(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	call, err := json.MarshalIndent(struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+		Result json.RawMessage `json:"result"`
+	}{method, params, data}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	hash := sha256.Sum256([]byte(method + string(params)))
+	name := filepath.Join("11155111", method+"-"+hex.EncodeToString(hash[:8])+".json")
+	if err = os.WriteFile(name, append(call, '\n'), 0o600); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("written %s", name)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
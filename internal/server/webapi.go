@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"expvar"
 	"net/http"
 	"strconv"
 	"time"
@@ -28,10 +29,27 @@ func NewServer(ctx context.Context, router *mux.Router, endPointProvider EndPoin
 	}
 }
 
-// Run method starts the http server
-func (web *WebServer) Run(port int) {
+// Run method starts the http server, along with the admin one, when its port is provided
+func (web *WebServer) Run(port, adminPort int) {
 	web.endPointProvider.Register(web.router)
-	httpServer := &http.Server{Addr: ":" + strconv.Itoa(port), Handler: web.router, ReadHeaderTimeout: 5 * time.Second}
+	if adminPort > 0 {
+		go web.serve(adminPort, NewAdminRouter())
+	}
+	web.serve(port, web.router)
+}
+
+// NewAdminRouter returns the router of the internal endpoints, e.g. the metrics, that must not be reachable
+// through the public API port
+func NewAdminRouter() *mux.Router {
+	router := mux.NewRouter()
+	router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
+	router.NotFoundHandler = http.HandlerFunc(NotImplemented)
+	return router
+}
+
+// serve runs the http server of the router, until the app context is done
+func (web *WebServer) serve(port int, handler http.Handler) {
+	httpServer := &http.Server{Addr: ":" + strconv.Itoa(port), Handler: handler, ReadHeaderTimeout: 5 * time.Second}
 
 	httpServerDone := make(chan struct{})
 
@@ -1,19 +1,122 @@
 package store
 
-import "ethereum-fetcher/internal/store/pg/models"
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
 
 // StorageProvider defines the base abstraction around ethereum tx store.
 //
 //go:generate mockery --name StorageProvider
 type StorageProvider interface {
-	GetUser(username, password string) (*models.User, error)
-	GetTransactionsByHashes(txHashes []string, userID int) ([]*models.Transaction, error)
-	GetAllTransactions() ([]*models.Transaction, error)
-	GetMyTransactions(userID int) ([]*models.Transaction, error)
-	InsertTransactions(txList []*models.Transaction, userID int) error
-	InsertTransactionsUser(txList []*models.Transaction, userID int) error
+	GetUser(ctx context.Context, username, password string) (*models.User, error)
+	GetTransactionsByHashes(ctx context.Context, chainID int64, txHashes []string) ([]*models.Transaction, error)
+	GetAllTransactions(ctx context.Context, chainID int64, filter Filter, page Page) ([]*models.Transaction, error)
+	GetMyTransactions(ctx context.Context, chainID int64, userID int, filter Filter, page Page) ([]*models.Transaction,
+		error)
+	StreamAllTransactions(ctx context.Context, chainID int64, filter Filter, fn StreamFunc) error
+	StreamMyTransactions(ctx context.Context, chainID int64, userID int, filter Filter, fn StreamFunc) error
+	GetPendingTransactions(ctx context.Context) ([]*models.Transaction, error)
+	GetTransactionsSinceBlock(ctx context.Context, chainID int64, blockNumber uint64) ([]*models.Transaction, error)
+	GetBlocks(ctx context.Context, chainID int64, blockHashes []string) ([]*models.Block, error)
+	GetTransactionLogs(ctx context.Context, chainID int64, txHashes []string) ([]*models.TransactionLog, error)
+	GetTransactionTraces(ctx context.Context, chainID int64, txHashes []string) ([]*models.TransactionTrace, error)
+	GetTokenTransfers(ctx context.Context, chainID int64, txHashes []string) ([]*models.TokenTransfer, error)
+	GetMyTokenTransfers(ctx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error)
+	InsertTransactions(ctx context.Context, txList []*TxRecord, userID int) error
+	InsertTransactionsUser(ctx context.Context, txList []*models.Transaction, userID int) error
+	AddWatchedAddress(ctx context.Context, watched *models.WatchedAddress) error
+	GetWatchedAddresses(ctx context.Context, chainID int64) ([]*models.WatchedAddress, error)
+	GetBackfillCheckpoint(ctx context.Context, chainID int64, job string) (*models.BackfillCheckpoint, error)
+	SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error
+	GetContract(ctx context.Context, chainID int64, address string) (*models.Contract, error)
+	UpsertContract(ctx context.Context, contract *models.Contract) error
+	// WithTx runs the unit of work within a single db transaction, committed unless the unit of work fails;
+	// the nested units of work join the outer one
+	WithTx(ctx context.Context, fn func(tx StorageProvider) error) error
+}
+
+// TxRecord is the transaction along with its block, the logs of its receipt and the token transfers parsed
+// out of them, they are always stored together
+type TxRecord struct {
+	*models.Transaction
+	Block     *models.Block
+	Logs      []*models.TransactionLog
+	Transfers []*models.TokenTransfer
+	// Traces are the call frames of the traced transaction, nil when it is not traced; the empty ones drop the
+	// stored frames
+	Traces []*models.TransactionTrace
+}
+
+// Period limits the transactions by the timestamp of their block, the zero time leaves that side open;
+// the pending transactions don't have block, so they are left out of any limited period
+type Period struct {
+	Since time.Time
+	Until time.Time
+}
+
+// IsZero reports whether the period is not limited at all
+func (p Period) IsZero() bool {
+	return p.Since.IsZero() && p.Until.IsZero()
+}
+
+// Filter narrows down the listed transactions and orders them, the zero filter lists all of them
+// by block number; the nil fields leave their condition out
+type Filter struct {
+	Period
+	From     string
+	To       string
+	Contract string
+	Status   *int
+	// FromBlock and ToBlock limit the block number, both of them inclusive
+	FromBlock *uint64
+	ToBlock   *uint64
+	// MinValue and MaxValue limit the value in wei, both of them inclusive
+	MinValue *big.Int
+	MaxValue *big.Int
+	// ContractCreation keeps either only the contract creations or only the other transactions
+	ContractCreation *bool
+	Sort             Sort
 }
 
+// Sort is the order of the listed transactions, the ties are always broken by the transaction hash
+type Sort int
+
+const (
+	// SortBlockNumber orders the transactions by ascending block number, the pending ones go last
+	SortBlockNumber Sort = iota
+	// SortValueDesc orders the transactions by descending value
+	SortValueDesc
+)
+
+// Page limits the transactions to the ones after the cursor in the order of the filter; the zero limit
+// leaves the page unlimited
+type Page struct {
+	Limit  int
+	Cursor *Cursor
+}
+
+// Cursor is the position right after the transaction in the ordered list of transactions; the pending
+// transactions don't have block number, so they are listed after the mined ones
+type Cursor struct {
+	BlockNumber types.NullDecimal
+	Value       string
+	TXHash      string
+}
+
+// NewCursor returns the cursor positioned right after the transaction
+func NewCursor(tx *models.Transaction) *Cursor {
+	return &Cursor{BlockNumber: tx.BlockNumber, Value: tx.Value, TXHash: tx.TXHash}
+}
+
+// StreamFunc receives the streamed transactions chunk by chunk, the returned error stops the stream
+type StreamFunc func(txList []*models.Transaction) error
+
 const (
 	NonAuthenticatedUser int = 0
 )
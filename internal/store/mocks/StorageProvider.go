@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	models "ethereum-fetcher/internal/store/pg/models"
 
 	mock "github.com/stretchr/testify/mock"
+
+	store "ethereum-fetcher/internal/store"
 )
 
 // StorageProvider is an autogenerated mock type for the StorageProvider type
@@ -13,9 +16,27 @@ type StorageProvider struct {
 	mock.Mock
 }
 
-// GetAllTransactions provides a mock function with given fields:
-func (_m *StorageProvider) GetAllTransactions() ([]*models.Transaction, error) {
-	ret := _m.Called()
+// AddWatchedAddress provides a mock function with given fields: ctx, watched
+func (_m *StorageProvider) AddWatchedAddress(ctx context.Context, watched *models.WatchedAddress) error {
+	ret := _m.Called(ctx, watched)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWatchedAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WatchedAddress) error); ok {
+		r0 = rf(ctx, watched)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllTransactions provides a mock function with given fields: ctx, chainID, filter, page
+func (_m *StorageProvider) GetAllTransactions(ctx context.Context, chainID int64, filter store.Filter, page store.Page) ([]*models.Transaction, error) {
+	ret := _m.Called(ctx, chainID, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTransactions")
@@ -23,19 +44,19 @@ func (_m *StorageProvider) GetAllTransactions() ([]*models.Transaction, error) {
 
 	var r0 []*models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*models.Transaction, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.Page) ([]*models.Transaction, error)); ok {
+		return rf(ctx, chainID, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func() []*models.Transaction); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.Page) []*models.Transaction); ok {
+		r0 = rf(ctx, chainID, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int64, store.Filter, store.Page) error); ok {
+		r1 = rf(ctx, chainID, filter, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,9 +64,129 @@ func (_m *StorageProvider) GetAllTransactions() ([]*models.Transaction, error) {
 	return r0, r1
 }
 
-// GetMyTransactions provides a mock function with given fields: userID
-func (_m *StorageProvider) GetMyTransactions(userID int) ([]*models.Transaction, error) {
-	ret := _m.Called(userID)
+// GetBackfillCheckpoint provides a mock function with given fields: ctx, chainID, job
+func (_m *StorageProvider) GetBackfillCheckpoint(ctx context.Context, chainID int64, job string) (*models.BackfillCheckpoint, error) {
+	ret := _m.Called(ctx, chainID, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackfillCheckpoint")
+	}
+
+	var r0 *models.BackfillCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*models.BackfillCheckpoint, error)); ok {
+		return rf(ctx, chainID, job)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *models.BackfillCheckpoint); ok {
+		r0 = rf(ctx, chainID, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BackfillCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, chainID, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlocks provides a mock function with given fields: ctx, chainID, blockHashes
+func (_m *StorageProvider) GetBlocks(ctx context.Context, chainID int64, blockHashes []string) ([]*models.Block, error) {
+	ret := _m.Called(ctx, chainID, blockHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocks")
+	}
+
+	var r0 []*models.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) ([]*models.Block, error)); ok {
+		return rf(ctx, chainID, blockHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []*models.Block); ok {
+		r0 = rf(ctx, chainID, blockHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, chainID, blockHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContract provides a mock function with given fields: ctx, chainID, address
+func (_m *StorageProvider) GetContract(ctx context.Context, chainID int64, address string) (*models.Contract, error) {
+	ret := _m.Called(ctx, chainID, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContract")
+	}
+
+	var r0 *models.Contract
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*models.Contract, error)); ok {
+		return rf(ctx, chainID, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *models.Contract); ok {
+		r0 = rf(ctx, chainID, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contract)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, chainID, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyTokenTransfers provides a mock function with given fields: ctx, chainID, userID
+func (_m *StorageProvider) GetMyTokenTransfers(ctx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error) {
+	ret := _m.Called(ctx, chainID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyTokenTransfers")
+	}
+
+	var r0 []*models.TokenTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*models.TokenTransfer, error)); ok {
+		return rf(ctx, chainID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*models.TokenTransfer); ok {
+		r0 = rf(ctx, chainID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TokenTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, chainID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMyTransactions provides a mock function with given fields: ctx, chainID, userID, filter, page
+func (_m *StorageProvider) GetMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter, page store.Page) ([]*models.Transaction, error) {
+	ret := _m.Called(ctx, chainID, userID, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMyTransactions")
@@ -53,19 +194,79 @@ func (_m *StorageProvider) GetMyTransactions(userID int) ([]*models.Transaction,
 
 	var r0 []*models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]*models.Transaction, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.Page) ([]*models.Transaction, error)); ok {
+		return rf(ctx, chainID, userID, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.Page) []*models.Transaction); ok {
+		r0 = rf(ctx, chainID, userID, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, store.Filter, store.Page) error); ok {
+		r1 = rf(ctx, chainID, userID, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingTransactions provides a mock function with given fields: ctx
+func (_m *StorageProvider) GetPendingTransactions(ctx context.Context) ([]*models.Transaction, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactions")
+	}
+
+	var r0 []*models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.Transaction, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(int) []*models.Transaction); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Transaction); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokenTransfers provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *StorageProvider) GetTokenTransfers(ctx context.Context, chainID int64, txHashes []string) ([]*models.TokenTransfer, error) {
+	ret := _m.Called(ctx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenTransfers")
+	}
+
+	var r0 []*models.TokenTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) ([]*models.TokenTransfer, error)); ok {
+		return rf(ctx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []*models.TokenTransfer); ok {
+		r0 = rf(ctx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TokenTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, chainID, txHashes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,9 +274,69 @@ func (_m *StorageProvider) GetMyTransactions(userID int) ([]*models.Transaction,
 	return r0, r1
 }
 
-// GetTransactionsByHashes provides a mock function with given fields: txHashes, userID
-func (_m *StorageProvider) GetTransactionsByHashes(txHashes []string, userID int) ([]*models.Transaction, error) {
-	ret := _m.Called(txHashes, userID)
+// GetTransactionLogs provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *StorageProvider) GetTransactionLogs(ctx context.Context, chainID int64, txHashes []string) ([]*models.TransactionLog, error) {
+	ret := _m.Called(ctx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionLogs")
+	}
+
+	var r0 []*models.TransactionLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) ([]*models.TransactionLog, error)); ok {
+		return rf(ctx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []*models.TransactionLog); ok {
+		r0 = rf(ctx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TransactionLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, chainID, txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionTraces provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *StorageProvider) GetTransactionTraces(ctx context.Context, chainID int64, txHashes []string) ([]*models.TransactionTrace, error) {
+	ret := _m.Called(ctx, chainID, txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionTraces")
+	}
+
+	var r0 []*models.TransactionTrace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) ([]*models.TransactionTrace, error)); ok {
+		return rf(ctx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []*models.TransactionTrace); ok {
+		r0 = rf(ctx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TransactionTrace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, chainID, txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionsByHashes provides a mock function with given fields: ctx, chainID, txHashes
+func (_m *StorageProvider) GetTransactionsByHashes(ctx context.Context, chainID int64, txHashes []string) ([]*models.Transaction, error) {
+	ret := _m.Called(ctx, chainID, txHashes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionsByHashes")
@@ -83,19 +344,49 @@ func (_m *StorageProvider) GetTransactionsByHashes(txHashes []string, userID int
 
 	var r0 []*models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]string, int) ([]*models.Transaction, error)); ok {
-		return rf(txHashes, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) ([]*models.Transaction, error)); ok {
+		return rf(ctx, chainID, txHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []*models.Transaction); ok {
+		r0 = rf(ctx, chainID, txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, chainID, txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionsSinceBlock provides a mock function with given fields: ctx, chainID, blockNumber
+func (_m *StorageProvider) GetTransactionsSinceBlock(ctx context.Context, chainID int64, blockNumber uint64) ([]*models.Transaction, error) {
+	ret := _m.Called(ctx, chainID, blockNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionsSinceBlock")
+	}
+
+	var r0 []*models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) ([]*models.Transaction, error)); ok {
+		return rf(ctx, chainID, blockNumber)
 	}
-	if rf, ok := ret.Get(0).(func([]string, int) []*models.Transaction); ok {
-		r0 = rf(txHashes, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) []*models.Transaction); ok {
+		r0 = rf(ctx, chainID, blockNumber)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]string, int) error); ok {
-		r1 = rf(txHashes, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, chainID, blockNumber)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,9 +394,9 @@ func (_m *StorageProvider) GetTransactionsByHashes(txHashes []string, userID int
 	return r0, r1
 }
 
-// GetUser provides a mock function with given fields: username, password
-func (_m *StorageProvider) GetUser(username string, password string) (*models.User, error) {
-	ret := _m.Called(username, password)
+// GetUser provides a mock function with given fields: ctx, username, password
+func (_m *StorageProvider) GetUser(ctx context.Context, username string, password string) (*models.User, error) {
+	ret := _m.Called(ctx, username, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUser")
@@ -113,19 +404,49 @@ func (_m *StorageProvider) GetUser(username string, password string) (*models.Us
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*models.User, error)); ok {
-		return rf(username, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.User, error)); ok {
+		return rf(ctx, username, password)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *models.User); ok {
-		r0 = rf(username, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.User); ok {
+		r0 = rf(ctx, username, password)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(username, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWatchedAddresses provides a mock function with given fields: ctx, chainID
+func (_m *StorageProvider) GetWatchedAddresses(ctx context.Context, chainID int64) ([]*models.WatchedAddress, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWatchedAddresses")
+	}
+
+	var r0 []*models.WatchedAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.WatchedAddress, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.WatchedAddress); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WatchedAddress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -133,17 +454,17 @@ func (_m *StorageProvider) GetUser(username string, password string) (*models.Us
 	return r0, r1
 }
 
-// InsertTransactions provides a mock function with given fields: txList, userID
-func (_m *StorageProvider) InsertTransactions(txList []*models.Transaction, userID int) error {
-	ret := _m.Called(txList, userID)
+// InsertTransactions provides a mock function with given fields: ctx, txList, userID
+func (_m *StorageProvider) InsertTransactions(ctx context.Context, txList []*store.TxRecord, userID int) error {
+	ret := _m.Called(ctx, txList, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertTransactions")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]*models.Transaction, int) error); ok {
-		r0 = rf(txList, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, []*store.TxRecord, int) error); ok {
+		r0 = rf(ctx, txList, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -151,17 +472,107 @@ func (_m *StorageProvider) InsertTransactions(txList []*models.Transaction, user
 	return r0
 }
 
-// InsertTransactionsUser provides a mock function with given fields: txList, userID
-func (_m *StorageProvider) InsertTransactionsUser(txList []*models.Transaction, userID int) error {
-	ret := _m.Called(txList, userID)
+// InsertTransactionsUser provides a mock function with given fields: ctx, txList, userID
+func (_m *StorageProvider) InsertTransactionsUser(ctx context.Context, txList []*models.Transaction, userID int) error {
+	ret := _m.Called(ctx, txList, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertTransactionsUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]*models.Transaction, int) error); ok {
-		r0 = rf(txList, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.Transaction, int) error); ok {
+		r0 = rf(ctx, txList, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveBackfillCheckpoint provides a mock function with given fields: ctx, checkpoint
+func (_m *StorageProvider) SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error {
+	ret := _m.Called(ctx, checkpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBackfillCheckpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.BackfillCheckpoint) error); ok {
+		r0 = rf(ctx, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamAllTransactions provides a mock function with given fields: ctx, chainID, filter, fn
+func (_m *StorageProvider) StreamAllTransactions(ctx context.Context, chainID int64, filter store.Filter, fn store.StreamFunc) error {
+	ret := _m.Called(ctx, chainID, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAllTransactions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, store.Filter, store.StreamFunc) error); ok {
+		r0 = rf(ctx, chainID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamMyTransactions provides a mock function with given fields: ctx, chainID, userID, filter, fn
+func (_m *StorageProvider) StreamMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter, fn store.StreamFunc) error {
+	ret := _m.Called(ctx, chainID, userID, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamMyTransactions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, store.Filter, store.StreamFunc) error); ok {
+		r0 = rf(ctx, chainID, userID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertContract provides a mock function with given fields: ctx, contract
+func (_m *StorageProvider) UpsertContract(ctx context.Context, contract *models.Contract) error {
+	ret := _m.Called(ctx, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertContract")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Contract) error); ok {
+		r0 = rf(ctx, contract)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *StorageProvider) WithTx(ctx context.Context, fn func(store.StorageProvider) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.StorageProvider) error) error); ok {
+		r0 = rf(ctx, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,252 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// the columns copied into the staging tables, in the order of the copied values
+var (
+	bulkBlockColumns = []string{
+		models.BlockColumns.ChainID, models.BlockColumns.BlockHash, models.BlockColumns.BlockNumber,
+		models.BlockColumns.ParentHash, models.BlockColumns.Timestamp, models.BlockColumns.BaseFeePerGas,
+		models.BlockColumns.Miner,
+	}
+	bulkTransactionColumns = []string{
+		models.TransactionColumns.ChainID, models.TransactionColumns.TXHash, models.TransactionColumns.TXStatus,
+		models.TransactionColumns.BlockHash, models.TransactionColumns.BlockNumber,
+		models.TransactionColumns.FromAddress, models.TransactionColumns.ToAddress,
+		models.TransactionColumns.ContractAddress, models.TransactionColumns.LogsCount, models.TransactionColumns.Input,
+		models.TransactionColumns.Value, models.TransactionColumns.Pending, models.TransactionColumns.Nonce,
+		models.TransactionColumns.TXType, models.TransactionColumns.GasLimit, models.TransactionColumns.GasPrice,
+		models.TransactionColumns.MaxFeePerGas, models.TransactionColumns.MaxPriorityFeePerGas,
+		models.TransactionColumns.MaxFeePerBlobGas, models.TransactionColumns.AccessList,
+		models.TransactionColumns.GasUsed, models.TransactionColumns.EffectiveGasPrice,
+		models.TransactionColumns.BlobGasUsed, models.TransactionColumns.BlobGasPrice,
+		models.TransactionColumns.Verified,
+	}
+	bulkTransactionLogColumns = []string{
+		models.TransactionLogColumns.ChainID, models.TransactionLogColumns.TXHash,
+		models.TransactionLogColumns.LogIndex, models.TransactionLogColumns.Address,
+		models.TransactionLogColumns.Topics, models.TransactionLogColumns.Data, models.TransactionLogColumns.Removed,
+	}
+	bulkTokenTransferColumns = []string{
+		models.TokenTransferColumns.ChainID, models.TokenTransferColumns.TXHash, models.TokenTransferColumns.LogIndex,
+		models.TokenTransferColumns.BatchIndex, models.TokenTransferColumns.Standard,
+		models.TokenTransferColumns.TokenAddress, models.TokenTransferColumns.Operator,
+		models.TokenTransferColumns.FromAddress, models.TokenTransferColumns.ToAddress,
+		models.TokenTransferColumns.TokenID, models.TokenTransferColumns.Amount,
+	}
+	bulkTransactionTraceColumns = []string{
+		models.TransactionTraceColumns.ChainID, models.TransactionTraceColumns.TXHash,
+		models.TransactionTraceColumns.TraceIndex, models.TransactionTraceColumns.CallType,
+		models.TransactionTraceColumns.FromAddress, models.TransactionTraceColumns.ToAddress,
+		models.TransactionTraceColumns.Value, models.TransactionTraceColumns.Gas,
+		models.TransactionTraceColumns.Error, models.TransactionTraceColumns.Depth,
+	}
+)
+
+// InsertTransactions inserts records in blocks, transactions, transaction_logs, token_transfers,
+// transaction_traces and user_transactions tables, all of them within a single unit of work
+func (st *Store) InsertTransactions(ctx context.Context, txList []*store.TxRecord, userID int) error {
+	if len(txList) == 0 {
+		return nil
+	}
+
+	txList = dedupRecords(txList)
+	err := st.withTx(ctx, func(tx *Store) error {
+		// COPY goes through the driver connection of the unit of work, within its db transaction
+		return tx.conn.Raw(func(driverConn any) error {
+			pgxConn := driverConn.(*stdlib.Conn).Conn()
+			if err := copyTransactions(ctx, pgxConn, txList); err != nil {
+				return err
+			}
+			return mergeTransactions(ctx, pgxConn, txList, userID)
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("cannot insert %d txs into the database: %v", len(txList), err)
+	}
+
+	return nil
+}
+
+// copyTransactions copies the rows of the records into the staging tables, which are dropped along with
+// the db transaction; the unit of work might insert more than once, so they are emptied first
+func copyTransactions(ctx context.Context, conn *pgx.Conn, txList []*store.TxRecord) error {
+	var blockRows, txRows, logRows, transferRows, traceRows [][]any
+	blocks := make(map[string]bool, len(txList))
+	for _, record := range txList {
+		if block := record.Block; block != nil && !blocks[block.BlockHash] {
+			blocks[block.BlockHash] = true
+			blockRows = append(blockRows, blockRow(block))
+		}
+		txRows = append(txRows, transactionRow(record.Transaction))
+		for _, txLog := range record.Logs {
+			logRows = append(logRows, []any{txLog.ChainID, txLog.TXHash, txLog.LogIndex, txLog.Address,
+				[]string(txLog.Topics), txLog.Data, txLog.Removed})
+		}
+		for _, transfer := range record.Transfers {
+			transferRows = append(transferRows, []any{transfer.ChainID, transfer.TXHash, transfer.LogIndex,
+				transfer.BatchIndex, transfer.Standard, transfer.TokenAddress, transfer.Operator.Ptr(),
+				transfer.FromAddress, transfer.ToAddress, transfer.TokenID.Ptr(), transfer.Amount})
+		}
+		for _, trace := range record.Traces {
+			traceRows = append(traceRows, []any{trace.ChainID, trace.TXHash, trace.TraceIndex, trace.CallType,
+				trace.FromAddress, trace.ToAddress.Ptr(), trace.Value, trace.Gas, trace.Error.Ptr(), trace.Depth})
+		}
+	}
+
+	for _, staged := range []struct {
+		table   string
+		columns []string
+		rows    [][]any
+	}{
+		{table: models.TableNames.Blocks, columns: bulkBlockColumns, rows: blockRows},
+		{table: models.TableNames.Transactions, columns: bulkTransactionColumns, rows: txRows},
+		{table: models.TableNames.TransactionLogs, columns: bulkTransactionLogColumns, rows: logRows},
+		{table: models.TableNames.TokenTransfers, columns: bulkTokenTransferColumns, rows: transferRows},
+		{table: models.TableNames.TransactionTraces, columns: bulkTransactionTraceColumns, rows: traceRows},
+	} {
+		_, err := conn.Exec(ctx, "CREATE TEMP TABLE IF NOT EXISTS "+stagingTable(staged.table)+
+			" (LIKE "+staged.table+" INCLUDING DEFAULTS) ON COMMIT DROP")
+		if err == nil {
+			_, err = conn.Exec(ctx, "TRUNCATE "+stagingTable(staged.table))
+		}
+		if err != nil {
+			return fmt.Errorf("cannot create staging table of %s: %v", staged.table, err)
+		}
+		if len(staged.rows) == 0 {
+			continue
+		}
+
+		_, err = conn.CopyFrom(ctx, pgx.Identifier{stagingTable(staged.table)}, staged.columns,
+			pgx.CopyFromRows(staged.rows))
+		if err != nil {
+			return fmt.Errorf("cannot copy rows of %s: %v", staged.table, err)
+		}
+	}
+
+	return nil
+}
+
+// mergeTransactions moves the staged rows into their tables in a single statement batch, with the same
+// conflict handling as the inserts one by one: the blocks are kept, the transactions are updated, while their
+// logs, token transfers and (only when traced) call frames are replaced
+func mergeTransactions(ctx context.Context, conn *pgx.Conn, txList []*store.TxRecord, userID int) error {
+	var tracedChains []int64
+	var tracedHashes []string
+	for _, record := range txList {
+		if record.Traces != nil {
+			tracedChains = append(tracedChains, record.ChainID)
+			tracedHashes = append(tracedHashes, record.TXHash)
+		}
+	}
+
+	updates := make([]string, 0, len(bulkTransactionColumns))
+	for _, column := range bulkTransactionColumns {
+		if column != models.TransactionColumns.ChainID && column != models.TransactionColumns.TXHash {
+			updates = append(updates, column+" = excluded."+column)
+		}
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(mergeQuery(models.TableNames.Blocks, bulkBlockColumns) +
+		" ON CONFLICT (chain_id, block_hash) DO NOTHING")
+	batch.Queue(mergeQuery(models.TableNames.Transactions, bulkTransactionColumns) +
+		" ON CONFLICT (chain_id, tx_hash) DO UPDATE SET " + strings.Join(updates, ", "))
+
+	for _, table := range []string{models.TableNames.TransactionLogs, models.TableNames.TokenTransfers} {
+		batch.Queue("DELETE FROM " + table + " r USING " + stagingTable(models.TableNames.Transactions) + " t" +
+			" WHERE r.chain_id = t.chain_id AND r.tx_hash = t.tx_hash")
+	}
+	batch.Queue(mergeQuery(models.TableNames.TransactionLogs, bulkTransactionLogColumns))
+	batch.Queue(mergeQuery(models.TableNames.TokenTransfers, bulkTokenTransferColumns))
+
+	if len(tracedHashes) > 0 {
+		batch.Queue("DELETE FROM "+models.TableNames.TransactionTraces+
+			" WHERE (chain_id, tx_hash) IN (SELECT * FROM UNNEST($1::BIGINT[], $2::VARCHAR[]))",
+			tracedChains, tracedHashes)
+		batch.Queue(mergeQuery(models.TableNames.TransactionTraces, bulkTransactionTraceColumns))
+	}
+
+	if userID != store.NonAuthenticatedUser {
+		batch.Queue("INSERT INTO "+models.TableNames.UserTransactions+" (user_id, chain_id, tx_hash)"+
+			" SELECT $1, chain_id, tx_hash FROM "+stagingTable(models.TableNames.Transactions)+
+			" ON CONFLICT (user_id, chain_id, tx_hash) DO NOTHING", userID)
+	}
+
+	return conn.SendBatch(ctx, batch).Close()
+}
+
+// mergeQuery inserts all staged rows of the table
+func mergeQuery(table string, columns []string) string {
+	list := strings.Join(columns, ", ")
+	return "INSERT INTO " + table + " (" + list + ") SELECT " + list + " FROM " + stagingTable(table)
+}
+
+// stagingTable is the name of the temporary table, the rows of the table are copied into
+func stagingTable(table string) string {
+	return "staged_" + table
+}
+
+// dedupRecords keeps the last record of each transaction, since a single upsert cannot update the same row twice
+func dedupRecords(txList []*store.TxRecord) []*store.TxRecord {
+	type key struct {
+		chainID int64
+		txHash  string
+	}
+
+	last := make(map[key]int, len(txList))
+	for i, record := range txList {
+		last[key{record.ChainID, record.TXHash}] = i
+	}
+	if len(last) == len(txList) {
+		return txList
+	}
+
+	deduped := make([]*store.TxRecord, 0, len(last))
+	for i, record := range txList {
+		if last[key{record.ChainID, record.TXHash}] == i {
+			deduped = append(deduped, record)
+		}
+	}
+	return deduped
+}
+
+// blockRow returns the values of the block in the order of bulkBlockColumns
+func blockRow(block *models.Block) []any {
+	return []any{block.ChainID, block.BlockHash, numeric(types.NewNullDecimal(block.BlockNumber.Big)),
+		block.ParentHash, block.Timestamp, block.BaseFeePerGas.Ptr(), block.Miner}
+}
+
+// transactionRow returns the values of the transaction in the order of bulkTransactionColumns
+func transactionRow(tx *models.Transaction) []any {
+	var accessList any
+	if tx.AccessList.Valid {
+		accessList = []byte(tx.AccessList.JSON)
+	}
+	return []any{tx.ChainID, tx.TXHash, tx.TXStatus.Ptr(), tx.BlockHash.Ptr(), numeric(tx.BlockNumber),
+		tx.FromAddress, tx.ToAddress.Ptr(), tx.ContractAddress.Ptr(), tx.LogsCount, tx.Input, tx.Value, tx.Pending,
+		tx.Nonce.Ptr(), tx.TXType.Ptr(), tx.GasLimit.Ptr(), tx.GasPrice.Ptr(), tx.MaxFeePerGas.Ptr(),
+		tx.MaxPriorityFeePerGas.Ptr(), tx.MaxFeePerBlobGas.Ptr(), accessList, tx.GasUsed.Ptr(),
+		tx.EffectiveGasPrice.Ptr(), tx.BlobGasUsed.Ptr(), tx.BlobGasPrice.Ptr(), tx.Verified}
+}
+
+// numeric converts the integer decimal into the value copied into a numeric column, the nil one is null
+func numeric(value types.NullDecimal) pgtype.Numeric {
+	if value.Big == nil {
+		return pgtype.Numeric{}
+	}
+	return pgtype.Numeric{Int: value.Int(new(big.Int)), Valid: true}
+}
@@ -0,0 +1,53 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/storetest"
+)
+
+// BenchmarkInsertTransactions compares the bulk path against the db transaction per record, on the batch size
+// of a 20-hash request and of a backfilled block
+func BenchmarkInsertTransactions(b *testing.B) {
+	vp := cmd.NewViper()
+	if vp.GetString(cmd.DBConnectionURL) == "" {
+		b.Skip("Skipping insert benchmark, since the env variable DB_CONNECTION_URL is not provided!")
+	}
+
+	cmd.LogInit("fatal")
+	ctx := context.Background()
+	st, err := NewStore(ctx, vp)
+	if err != nil {
+		b.Fatalf("cannot initialize database: %v", err)
+	}
+
+	for _, size := range []int{20, 200} {
+		for _, bench := range []struct {
+			name   string
+			insert func(context.Context, []*store.TxRecord, int) error
+		}{
+			{name: "each", insert: st.insertTransactionsEach},
+			{name: "bulk", insert: st.InsertTransactions},
+		} {
+			b.Run(fmt.Sprintf("%s/%d", bench.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					records := storetest.MockBulkRecords(i, size)
+					b.StartTimer()
+
+					if err := bench.insert(ctx, records, store.NonAuthenticatedUser); err != nil {
+						b.Fatalf("cannot insert transactions: %v", err)
+					}
+
+					b.StopTimer()
+					storetest.DeleteRecords(ctx, st.db, records)
+					b.StartTimer()
+				}
+			})
+		}
+	}
+}
@@ -10,25 +10,41 @@ import (
 	"ethereum-fetcher/internal/store"
 	"ethereum-fetcher/internal/store/pg/models"
 
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+const (
+	// streamCursor is the name of the server-side cursor of the transactions stream
+	streamCursor = "tx_stream"
+	// streamFetchSize is the number of transactions fetched at once from the server-side cursor
+	streamFetchSize = 500
+)
+
+// Store is the postgres storage, the store bound to a unit of work runs all of its queries within the db
+// transaction of that unit of work, while the unbound one runs them on the connection pool
 type Store struct {
-	ctx     context.Context
-	db      *sql.DB
-	txCount int
+	db *sql.DB
+	// conn and tx are the connection and the db transaction of the unit of work, nil outside of it
+	conn *sql.Conn
+	tx   *sql.Tx
 }
 
-const ErrDuplicateRowCode = "23505"
+// executor returns the db transaction of the unit of work, or the connection pool outside of it
+func (st *Store) executor() boil.ContextExecutor {
+	if st.tx != nil {
+		return st.tx
+	}
+	return st.db
+}
 
-func (st *Store) GetUser(username, password string) (*models.User, error) {
+func (st *Store) GetUser(ctx context.Context, username, password string) (*models.User, error) {
 	user, err := models.Users(
 		qm.Select(models.UserColumns.ID),
 		qm.Where(models.UserColumns.Username+"=?", username),
 		qm.And(models.UserColumns.Password+"= crypt(?, "+models.UserColumns.Password+")", password),
-	).One(st.ctx, boil.GetContextDB())
+	).One(ctx, st.executor())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return &models.User{ID: store.NonAuthenticatedUser}, nil
@@ -39,10 +55,11 @@ func (st *Store) GetUser(username, password string) (*models.User, error) {
 	return user, nil
 }
 
-func (st *Store) GetAllTransactions() ([]*models.Transaction, error) {
-	// results are not limited, but it is better to have either a pagination or
-	// CURSOR + FETCH + golang chan to stream the results back
-	txList, err := models.Transactions().All(st.ctx, boil.GetContextDB())
+// GetAllTransactions returns the page of the stored transactions of the chain, that pass the filter
+func (st *Store) GetAllTransactions(ctx context.Context, chainID int64, filter store.Filter, page store.Page) (
+	[]*models.Transaction, error) {
+	mods := append(allTransactionsMods(chainID, filter), pageMods(filter.Sort, page)...)
+	txList, err := models.Transactions(mods...).All(ctx, st.executor())
 	if err != nil {
 		return nil, fmt.Errorf("cannot select all tx from database: %v", err)
 	}
@@ -50,13 +67,11 @@ func (st *Store) GetAllTransactions() ([]*models.Transaction, error) {
 	return txList, nil
 }
 
-func (st *Store) GetMyTransactions(userID int) ([]*models.Transaction, error) {
-	txList, err := models.Transactions(
-		qm.InnerJoin(models.TableNames.UserTransactions+" ut on "+
-			"ut."+models.TransactionColumns.TXHash+" = "+
-			models.TableNames.Transactions+"."+models.TransactionColumns.TXHash),
-		qm.Where("ut.user_id = ?", userID),
-	).All(st.ctx, boil.GetContextDB())
+// GetMyTransactions returns the page of the stored transactions of the chain and the user, that pass the filter
+func (st *Store) GetMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter,
+	page store.Page) ([]*models.Transaction, error) {
+	mods := append(myTransactionsMods(chainID, userID, filter), pageMods(filter.Sort, page)...)
+	txList, err := models.Transactions(mods...).All(ctx, st.executor())
 	if err != nil {
 		return nil, fmt.Errorf("cannot select all tx from database: %v", err)
 	}
@@ -64,7 +79,208 @@ func (st *Store) GetMyTransactions(userID int) ([]*models.Transaction, error) {
 	return txList, nil
 }
 
-func (st *Store) GetTransactionsByHashes(txHashes []string, userID int) ([]*models.Transaction, error) {
+// StreamAllTransactions streams all stored transactions of the chain, that pass the filter, in the order
+// of the pages
+func (st *Store) StreamAllTransactions(ctx context.Context, chainID int64, filter store.Filter,
+	fn store.StreamFunc) error {
+	mods := append(allTransactionsMods(chainID, filter), pageMods(filter.Sort, store.Page{})...)
+	return st.streamTransactions(ctx, mods, fn)
+}
+
+// StreamMyTransactions streams all stored transactions of the chain and the user, that pass the filter,
+// in the order of the pages
+func (st *Store) StreamMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter,
+	fn store.StreamFunc) error {
+	mods := append(myTransactionsMods(chainID, userID, filter), pageMods(filter.Sort, store.Page{})...)
+	return st.streamTransactions(ctx, mods, fn)
+}
+
+// streamTransactions reads the transactions through the server-side cursor, so that only a single chunk
+// of them is held in memory at a time
+func (st *Store) streamTransactions(ctx context.Context, mods []qm.QueryMod, fn store.StreamFunc) error {
+	// the cursor lives only within the db transaction; nothing is written, so committing it is the same as
+	// rolling it back
+	return st.withTx(ctx, func(tx *Store) error {
+		query, args := queries.BuildQuery(models.Transactions(mods...).Query)
+		_, err := tx.tx.ExecContext(ctx, "DECLARE "+streamCursor+" NO SCROLL CURSOR FOR "+
+			strings.TrimSuffix(query, ";"), args...)
+		if err != nil {
+			return fmt.Errorf("cannot declare cursor to stream tx from database: %v", err)
+		}
+		// the outer unit of work might outlive the stream
+		defer func() {
+			_, _ = tx.tx.ExecContext(ctx, "CLOSE "+streamCursor)
+		}()
+
+		fetch := fmt.Sprintf("FETCH %d FROM %s", streamFetchSize, streamCursor)
+		for {
+			var txList []*models.Transaction
+			if err = queries.Raw(fetch).Bind(ctx, tx.tx, &txList); err != nil {
+				return fmt.Errorf("cannot fetch streamed tx from database: %v", err)
+			}
+			if len(txList) == 0 {
+				return nil
+			}
+			if err = fn(txList); err != nil {
+				return err
+			}
+			if len(txList) < streamFetchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// allTransactionsMods selects the transactions of the chain, that pass the filter
+func allTransactionsMods(chainID int64, filter store.Filter) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		models.TransactionWhere.ChainID.EQ(chainID),
+	}
+	return append(mods, filterMods(filter)...)
+}
+
+// myTransactionsMods selects the transactions of the chain and the user, that pass the filter
+func myTransactionsMods(chainID int64, userID int, filter store.Filter) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		qm.InnerJoin(models.TableNames.UserTransactions + " ut on " +
+			"ut." + models.UserTransactionColumns.ChainID + " = " +
+			models.TableNames.Transactions + "." + models.TransactionColumns.ChainID + " and " +
+			"ut." + models.UserTransactionColumns.TXHash + " = " +
+			models.TableNames.Transactions + "." + models.TransactionColumns.TXHash),
+		qm.Where("ut."+models.UserTransactionColumns.UserID+" = ?", userID),
+		models.TransactionWhere.ChainID.EQ(chainID),
+	}
+	return append(mods, filterMods(filter)...)
+}
+
+// filterMods turns the conditions of the filter into the where clauses, the value is stored as decimal text,
+// so it is compared as numeric
+func filterMods(filter store.Filter) []qm.QueryMod {
+	column := func(name string) string {
+		return models.TableNames.Transactions + "." + name
+	}
+
+	mods := periodMods(filter.Period)
+	if filter.From != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.FromAddress)+" = ?", filter.From))
+	}
+	if filter.To != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.ToAddress)+" = ?", filter.To))
+	}
+	if filter.Contract != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" = ?", filter.Contract))
+	}
+	if filter.Status != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.TXStatus)+" = ?", *filter.Status))
+	}
+	if filter.FromBlock != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.BlockNumber)+" >= ?", *filter.FromBlock))
+	}
+	if filter.ToBlock != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.BlockNumber)+" <= ?", *filter.ToBlock))
+	}
+	if filter.MinValue != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.Value)+"::numeric >= ?",
+			filter.MinValue.String()))
+	}
+	if filter.MaxValue != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.Value)+"::numeric <= ?",
+			filter.MaxValue.String()))
+	}
+	if filter.ContractCreation != nil {
+		if *filter.ContractCreation {
+			mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" is not null"))
+		} else {
+			mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" is null"))
+		}
+	}
+	return mods
+}
+
+// pageMods orders the transactions, and limits them to the page right after the cursor; the ties are broken
+// by the hash and the pending transactions, with null block number, are ordered last by block number
+func pageMods(sort store.Sort, page store.Page) []qm.QueryMod {
+	blockNumber := models.TableNames.Transactions + "." + models.TransactionColumns.BlockNumber
+	value := models.TableNames.Transactions + "." + models.TransactionColumns.Value + "::numeric"
+	txHash := models.TableNames.Transactions + "." + models.TransactionColumns.TXHash
+
+	var mods []qm.QueryMod
+	switch sort {
+	case store.SortValueDesc:
+		mods = append(mods, qm.OrderBy(value+" desc, "+txHash+" asc"))
+		if cursor := page.Cursor; cursor != nil {
+			mods = append(mods, qm.Where("("+value+" < ? or ("+value+" = ? and "+txHash+" > ?))",
+				cursor.Value, cursor.Value, cursor.TXHash))
+		}
+	default:
+		mods = append(mods, qm.OrderBy(blockNumber+" asc nulls last, "+txHash+" asc"))
+		if cursor := page.Cursor; cursor != nil {
+			if cursor.BlockNumber.Big == nil {
+				mods = append(mods, qm.Where(blockNumber+" is null and "+txHash+" > ?", cursor.TXHash))
+			} else {
+				mods = append(mods, qm.Where("("+blockNumber+" > ? or ("+blockNumber+" = ? and "+txHash+" > ?) or "+
+					blockNumber+" is null)", cursor.BlockNumber, cursor.BlockNumber, cursor.TXHash))
+			}
+		}
+	}
+	if page.Limit > 0 {
+		mods = append(mods, qm.Limit(page.Limit))
+	}
+	return mods
+}
+
+// periodMods limits the transactions by the timestamp of their block, joined only when needed
+func periodMods(period store.Period) []qm.QueryMod {
+	if period.IsZero() {
+		return nil
+	}
+
+	transactions := models.TableNames.Transactions
+	mods := []qm.QueryMod{
+		qm.Select(transactions + ".*"),
+		qm.InnerJoin(models.TableNames.Blocks + " b on " +
+			"b." + models.BlockColumns.ChainID + " = " + transactions + "." + models.TransactionColumns.ChainID +
+			" and b." + models.BlockColumns.BlockHash + " = " + transactions + "." + models.TransactionColumns.BlockHash),
+	}
+	if !period.Since.IsZero() {
+		mods = append(mods, qm.Where("b."+models.BlockColumns.Timestamp+" >= ?", period.Since))
+	}
+	if !period.Until.IsZero() {
+		mods = append(mods, qm.Where("b."+models.BlockColumns.Timestamp+" < ?", period.Until))
+	}
+	return mods
+}
+
+// GetPendingTransactions returns the transactions of all chains that are not mined yet
+func (st *Store) GetPendingTransactions(ctx context.Context) ([]*models.Transaction, error) {
+	txList, err := models.Transactions(
+		models.TransactionWhere.Pending.EQ(true),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select pending tx from database: %v", err)
+	}
+
+	return txList, nil
+}
+
+// GetTransactionsSinceBlock returns the mined transactions of the chain with block number greater than
+// the provided one
+func (st *Store) GetTransactionsSinceBlock(ctx context.Context, chainID int64, blockNumber uint64) (
+	[]*models.Transaction, error) {
+	txList, err := models.Transactions(
+		models.TransactionWhere.ChainID.EQ(chainID),
+		models.TransactionWhere.Pending.EQ(false),
+		qm.Where(models.TransactionColumns.BlockNumber+" > ?", blockNumber),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx from database since block %d: %v", blockNumber, err)
+	}
+
+	return txList, nil
+}
+
+func (st *Store) GetTransactionsByHashes(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.Transaction, error) {
 	columns := strings.Join([]string{
 		"t." + models.TransactionColumns.TXHash,
 		"t." + models.TransactionColumns.TXStatus,
@@ -76,73 +292,249 @@ func (st *Store) GetTransactionsByHashes(txHashes []string, userID int) ([]*mode
 		"t." + models.TransactionColumns.LogsCount,
 		"t." + models.TransactionColumns.Input,
 		"t." + models.TransactionColumns.Value,
+		"t." + models.TransactionColumns.Pending,
+		"t." + models.TransactionColumns.ChainID,
+		"t." + models.TransactionColumns.Nonce,
+		"t." + models.TransactionColumns.TXType,
+		"t." + models.TransactionColumns.GasLimit,
+		"t." + models.TransactionColumns.GasPrice,
+		"t." + models.TransactionColumns.MaxFeePerGas,
+		"t." + models.TransactionColumns.MaxPriorityFeePerGas,
+		"t." + models.TransactionColumns.MaxFeePerBlobGas,
+		"t." + models.TransactionColumns.AccessList,
+		"t." + models.TransactionColumns.GasUsed,
+		"t." + models.TransactionColumns.EffectiveGasPrice,
+		"t." + models.TransactionColumns.BlobGasUsed,
+		"t." + models.TransactionColumns.BlobGasPrice,
+		"t." + models.TransactionColumns.Verified,
 	}, ", ")
 
 	baseQuery := `
         SELECT %s FROM %s t
-        WHERE t.%s IN (
-            SELECT LOWER(tx_hash) FROM unnest($1::TEXT[]) AS hashes(tx_hash)
+        WHERE t.%s = $1 AND t.%s IN (
+            SELECT LOWER(tx_hash) FROM unnest($2::TEXT[]) AS hashes(tx_hash)
         )
     `
 
-	queryMods := []qm.QueryMod{
+	txList, err := models.Transactions(
 		qm.SQL(
 			fmt.Sprintf(baseQuery,
 				columns,
 				models.TableNames.Transactions,
+				models.TransactionColumns.ChainID,
 				models.TransactionColumns.TXHash,
 			),
+			chainID,
 			txHashes,
 		),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx from database by provided tx hashes: %v", err)
+	}
+
+	return txList, nil
+}
+
+// GetBlocks returns the blocks of the chain by their hashes
+func (st *Store) GetBlocks(ctx context.Context, chainID int64, blockHashes []string) ([]*models.Block, error) {
+	blockList, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(chainID),
+		models.BlockWhere.BlockHash.IN(blockHashes),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select blocks from database by provided block hashes: %v", err)
 	}
 
-	if userID != store.NonAuthenticatedUser {
-		// load only the authenticated user for those transactions
-		queryMods = append(queryMods,
-			qm.Load(qm.Rels(models.TransactionRels.Users), qm.Where("user_id = ?", userID)),
-		)
+	return blockList, nil
+}
+
+// GetTransactionLogs returns the receipt logs of the chain transactions, ordered by their index
+func (st *Store) GetTransactionLogs(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TransactionLog, error) {
+	logList, err := models.TransactionLogs(
+		models.TransactionLogWhere.ChainID.EQ(chainID),
+		models.TransactionLogWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TransactionLogColumns.TXHash+", "+models.TransactionLogColumns.LogIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx logs from database by provided tx hashes: %v", err)
 	}
 
-	txList, err := models.Transactions(queryMods...).All(st.ctx, boil.GetContextDB())
+	return logList, nil
+}
+
+// GetTransactionTraces returns the call frames of the chain transactions, in the order they were called
+func (st *Store) GetTransactionTraces(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TransactionTrace, error) {
+	traceList, err := models.TransactionTraces(
+		models.TransactionTraceWhere.ChainID.EQ(chainID),
+		models.TransactionTraceWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TransactionTraceColumns.TXHash+", "+models.TransactionTraceColumns.TraceIndex),
+	).All(ctx, st.executor())
 	if err != nil {
-		return nil, fmt.Errorf("cannot select tx from database by provided tx hashes: %v", err)
+		return nil, fmt.Errorf("cannot select tx traces from database by provided tx hashes: %v", err)
 	}
 
-	return txList, nil
+	return traceList, nil
 }
 
-// InsertTransactions inserts records in both transactions and user_transactions tables
-func (st *Store) InsertTransactions(txList []*models.Transaction, userID int) error {
-	for _, tx := range txList {
-		// upsert operation for each ethereum transaction
+// GetTokenTransfers returns the token transfers of the chain transactions, ordered by their log
+func (st *Store) GetTokenTransfers(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TokenTransfer, error) {
+	transferList, err := models.TokenTransfers(
+		models.TokenTransferWhere.ChainID.EQ(chainID),
+		models.TokenTransferWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TokenTransferColumns.TXHash+", "+models.TokenTransferColumns.LogIndex+", "+
+			models.TokenTransferColumns.BatchIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select token transfers from database by provided tx hashes: %v", err)
+	}
 
-		// first start dbTX, to ensure that both eth TX and user/TX are inserted
-		dbTx, err := st.BeginTx()
-		if err != nil {
-			return fmt.Errorf("cannot insert tx into the database for hash '%s': %v", tx.TXHash, err)
-		}
+	return transferList, nil
+}
 
-		err = tx.Upsert(st.ctx, dbTx, true, []string{models.TransactionColumns.TXHash},
-			boil.Infer(), boil.Infer())
-		if err != nil {
-			_ = st.RollbackTx(dbTx)
-			return fmt.Errorf("cannot insert tx into the database for hash '%s': %v", tx.TXHash, err)
-		}
+// GetMyTokenTransfers returns the token transfers of all the user transactions of the chain, in the order
+// they were mined
+func (st *Store) GetMyTokenTransfers(ctx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error) {
+	transfers := models.TableNames.TokenTransfers
+	transferList, err := models.TokenTransfers(
+		qm.Select(transfers+".*"),
+		qm.InnerJoin(models.TableNames.UserTransactions+" ut on "+
+			"ut."+models.UserTransactionColumns.ChainID+" = "+transfers+"."+models.TokenTransferColumns.ChainID+
+			" and ut."+models.UserTransactionColumns.TXHash+" = "+transfers+"."+models.TokenTransferColumns.TXHash),
+		qm.InnerJoin(models.TableNames.Transactions+" t on "+
+			"t."+models.TransactionColumns.ChainID+" = "+transfers+"."+models.TokenTransferColumns.ChainID+
+			" and t."+models.TransactionColumns.TXHash+" = "+transfers+"."+models.TokenTransferColumns.TXHash),
+		qm.Where("ut."+models.UserTransactionColumns.UserID+" = ?", userID),
+		models.TokenTransferWhere.ChainID.EQ(chainID),
+		qm.OrderBy("t."+models.TransactionColumns.BlockNumber+", "+
+			transfers+"."+models.TokenTransferColumns.LogIndex+", "+
+			transfers+"."+models.TokenTransferColumns.BatchIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select my token transfers from database: %v", err)
+	}
+
+	return transferList, nil
+}
+
+// insertTransactionsEach inserts records in blocks, transactions, transaction_logs, token_transfers,
+// transaction_traces and user_transactions tables, one unit of work per record
+func (st *Store) insertTransactionsEach(ctx context.Context, txList []*store.TxRecord, userID int) error {
+	for _, record := range txList {
+		// the eth TX, its block, logs, token transfers and user/TX are inserted together
+		err := st.withTx(ctx, func(dbTx *Store) error {
+			tx := record.Transaction
 
-		if userID != store.NonAuthenticatedUser {
-			user := &models.User{
-				ID: userID,
+			// the block goes first, since the transaction references it
+			if err := dbTx.insertBlock(ctx, dbTx.tx, record.Block); err != nil {
+				return err
 			}
-			err := tx.AddUsers(st.ctx, dbTx, false, user)
+
+			err := tx.Upsert(ctx, dbTx.tx, true,
+				[]string{models.TransactionColumns.ChainID, models.TransactionColumns.TXHash},
+				boil.Infer(), boil.Infer())
 			if err != nil {
-				_ = st.RollbackTx(dbTx)
-				return fmt.Errorf("cannot insert tx/user into the database for hash '%s': %v", tx.TXHash, err)
+				return fmt.Errorf("cannot insert tx into the database for hash '%s': %v", tx.TXHash, err)
 			}
-		}
 
-		err = st.CommitTx(dbTx)
+			if err = dbTx.replaceTransactionLogs(ctx, dbTx.tx, tx, record.Logs); err != nil {
+				return err
+			}
+			if err = dbTx.replaceTokenTransfers(ctx, dbTx.tx, tx, record.Transfers); err != nil {
+				return err
+			}
+			if err = dbTx.replaceTransactionTraces(ctx, dbTx.tx, tx, record.Traces); err != nil {
+				return err
+			}
+			return dbTx.insertUserTransaction(ctx, dbTx.tx, tx, userID)
+		})
 		if err != nil {
-			return fmt.Errorf("cannot insert tx into the database for hash '%s': %v", tx.TXHash, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertBlock stores the block of the mined transaction, unless it is already stored by another one of its
+// transactions
+func (st *Store) insertBlock(ctx context.Context, exec boil.ContextExecutor, block *models.Block) error {
+	if block == nil {
+		return nil
+	}
+
+	err := block.Upsert(ctx, exec, false,
+		[]string{models.BlockColumns.ChainID, models.BlockColumns.BlockHash},
+		boil.None(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert block into the database for hash '%s': %v", block.BlockHash, err)
+	}
+
+	return nil
+}
+
+// replaceTransactionLogs stores the current logs of the transaction, the ones of a previous receipt
+// (e.g. before a reorg) are dropped
+func (st *Store) replaceTransactionLogs(ctx context.Context, exec boil.ContextExecutor, tx *models.Transaction,
+	logList []*models.TransactionLog) error {
+	_, err := models.TransactionLogs(
+		models.TransactionLogWhere.ChainID.EQ(tx.ChainID),
+		models.TransactionLogWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("cannot delete tx logs from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, txLog := range logList {
+		if err = txLog.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert tx log into the database for hash '%s': %v", tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceTokenTransfers stores the current token transfers of the transaction, like its logs
+func (st *Store) replaceTokenTransfers(ctx context.Context, exec boil.ContextExecutor, tx *models.Transaction,
+	transferList []*models.TokenTransfer) error {
+	_, err := models.TokenTransfers(
+		models.TokenTransferWhere.ChainID.EQ(tx.ChainID),
+		models.TokenTransferWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("cannot delete token transfers from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, transfer := range transferList {
+		if err = transfer.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert token transfer into the database for hash '%s': %v", tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceTransactionTraces stores the current call frames of the traced transaction, like its logs; the stored
+// frames are kept when the transaction is not traced, e.g. the tracing got switched off meanwhile
+func (st *Store) replaceTransactionTraces(ctx context.Context, exec boil.ContextExecutor, tx *models.Transaction,
+	traceList []*models.TransactionTrace) error {
+	if traceList == nil {
+		return nil
+	}
+
+	_, err := models.TransactionTraces(
+		models.TransactionTraceWhere.ChainID.EQ(tx.ChainID),
+		models.TransactionTraceWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("cannot delete tx traces from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, trace := range traceList {
+		if err = trace.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert tx trace into the database for hash '%s': %v", tx.TXHash, err)
 		}
 	}
 
@@ -150,58 +542,178 @@ func (st *Store) InsertTransactions(txList []*models.Transaction, userID int) er
 }
 
 // InsertTransactionsUser inserts record in the join "user_transactions" table if needed
-func (st *Store) InsertTransactionsUser(txList []*models.Transaction, userID int) error {
+func (st *Store) InsertTransactionsUser(ctx context.Context, txList []*models.Transaction, userID int) error {
 	for _, tx := range txList {
-		// add user/txs to the join table
-		if userID != store.NonAuthenticatedUser {
-			user := &models.User{
-				ID: userID,
-			}
-			// and in case current user is not yet added - do it now
-			if len(tx.R.GetUsers()) == 0 {
-				err := tx.AddUsers(st.ctx, boil.GetContextDB(), false, user)
-				if err != nil {
-					if pgErr := new(pgconn.PgError); !errors.As(err, &pgErr) || pgErr.Code != ErrDuplicateRowCode {
-						return fmt.Errorf("cannot insert tx/user into the database for hash '%s': %v", tx.TXHash, err)
-					}
-				}
-			}
+		if err := st.insertUserTransaction(ctx, st.executor(), tx, userID); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (st *Store) BeginTx() (*sql.Tx, error) {
-	if _, okTx := boil.GetContextDB().(boil.ContextBeginner); !okTx {
-		dbTx, okTx := boil.GetContextDB().(*sql.Tx)
-		if !okTx {
-			return nil, fmt.Errorf("cannot obtain currently started sql Tx")
-		}
-		st.txCount++
-		return dbTx, nil
+// insertUserTransaction adds the user/tx to the join table, unless the user is not authenticated
+// or it is already added
+func (st *Store) insertUserTransaction(ctx context.Context, exec boil.ContextExecutor, tx *models.Transaction,
+	userID int) error {
+	if userID == store.NonAuthenticatedUser {
+		return nil
 	}
 
-	dbTx, err := boil.BeginTx(st.ctx, nil)
+	userTx := &models.UserTransaction{
+		UserID:  userID,
+		ChainID: tx.ChainID,
+		TXHash:  tx.TXHash,
+	}
+	err := userTx.Upsert(ctx, exec, false, []string{
+		models.UserTransactionColumns.UserID,
+		models.UserTransactionColumns.ChainID,
+		models.UserTransactionColumns.TXHash,
+	}, boil.None(), boil.Infer())
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("cannot insert tx/user into the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	return nil
+}
+
+// AddWatchedAddress registers the (lower case) address watched by the user, unless it is already watched
+func (st *Store) AddWatchedAddress(ctx context.Context, watched *models.WatchedAddress) error {
+	err := watched.Upsert(ctx, st.executor(), false, []string{
+		models.WatchedAddressColumns.UserID,
+		models.WatchedAddressColumns.ChainID,
+		models.WatchedAddressColumns.Address,
+	}, boil.None(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert watched address '%s' into the database: %v", watched.Address, err)
 	}
-	st.txCount++
-	return dbTx, nil
+
+	return nil
 }
 
-func (st *Store) CommitTx(dbTx *sql.Tx) error {
-	st.txCount--
-	if st.txCount <= 0 {
-		return dbTx.Commit()
+// GetWatchedAddresses returns the addresses watched by all the users on the chain
+func (st *Store) GetWatchedAddresses(ctx context.Context, chainID int64) ([]*models.WatchedAddress, error) {
+	watchedList, err := models.WatchedAddresses(
+		models.WatchedAddressWhere.ChainID.EQ(chainID),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select watched addresses from database: %v", err)
+	}
+
+	return watchedList, nil
+}
+
+// GetBackfillCheckpoint returns the progress of the backfill job, or nil when it is not started yet
+func (st *Store) GetBackfillCheckpoint(ctx context.Context, chainID int64, job string) (
+	*models.BackfillCheckpoint, error) {
+	checkpoint, err := models.FindBackfillCheckpoint(ctx, st.executor(), chainID, job)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot select backfill checkpoint '%s' from database: %v", job, err)
+	}
+
+	return checkpoint, nil
+}
+
+// SaveBackfillCheckpoint stores the progress of the backfill job, replacing the previous one
+func (st *Store) SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error {
+	err := checkpoint.Upsert(ctx, st.executor(), true,
+		[]string{models.BackfillCheckpointColumns.ChainID, models.BackfillCheckpointColumns.Job},
+		boil.Whitelist(models.BackfillCheckpointColumns.NextBlock, models.BackfillCheckpointColumns.UpdatedAt),
+		boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert backfill checkpoint '%s' into the database: %v", checkpoint.Job, err)
 	}
 
 	return nil
 }
 
-func (st *Store) RollbackTx(dbTx *sql.Tx) error {
-	st.txCount--
-	if st.txCount <= 0 {
-		return dbTx.Rollback()
+// GetContract returns the contract of the chain by its (lower case) address, or nil when its ABI is not uploaded
+func (st *Store) GetContract(ctx context.Context, chainID int64, address string) (*models.Contract, error) {
+	contract, err := models.FindContract(ctx, st.executor(), chainID, address)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot select contract '%s' from database: %v", address, err)
+	}
+
+	return contract, nil
+}
+
+// UpsertContract stores the contract ABI, replacing the previously uploaded one
+func (st *Store) UpsertContract(ctx context.Context, contract *models.Contract) error {
+	err := contract.Upsert(ctx, st.executor(), true,
+		[]string{models.ContractColumns.ChainID, models.ContractColumns.Address},
+		boil.Whitelist(models.ContractColumns.Abi), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert contract '%s' into the database: %v", contract.Address, err)
 	}
+
 	return nil
 }
+
+// WithTx runs the unit of work within a single db transaction, committed unless the unit of work fails;
+// the nested units of work join the outer one
+func (st *Store) WithTx(ctx context.Context, fn func(tx store.StorageProvider) error) error {
+	return st.withTx(ctx, func(tx *Store) error {
+		return fn(tx)
+	})
+}
+
+// withTx runs the unit of work on the store bound to it, the store already bound to one just joins it
+func (st *Store) withTx(ctx context.Context, fn func(tx *Store) error) error {
+	if st.tx != nil {
+		return fn(st)
+	}
+
+	tx, err := st.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.end(false)
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		_ = tx.end(false)
+		return err
+	}
+	return tx.end(true)
+}
+
+// begin starts the unit of work on a connection of its own, so that the bulk insert can COPY within it,
+// and returns the store bound to it
+func (st *Store) begin(ctx context.Context) (*Store, error) {
+	conn, err := st.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start db transaction: %v", err)
+	}
+
+	dbTx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("cannot start db transaction: %v", err)
+	}
+
+	return &Store{db: st.db, conn: conn, tx: dbTx}, nil
+}
+
+// end commits or rolls back the db transaction of the unit of work and returns its connection to the pool
+func (st *Store) end(commit bool) error {
+	defer func() {
+		_ = st.conn.Close()
+	}()
+
+	if commit {
+		if err := st.tx.Commit(); err != nil {
+			return fmt.Errorf("cannot commit db transaction: %v", err)
+		}
+		return nil
+	}
+	return st.tx.Rollback()
+}
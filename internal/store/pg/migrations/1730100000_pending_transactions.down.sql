@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transactions_pending;
+DELETE FROM transactions WHERE pending;
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS pending,
+    ALTER COLUMN tx_status SET NOT NULL,
+    ALTER COLUMN block_hash SET NOT NULL,
+    ALTER COLUMN block_number SET NOT NULL;
@@ -0,0 +1,9 @@
+-- pending transactions are stored before they are mined, so they don't have block and status yet
+ALTER TABLE transactions
+    ALTER COLUMN tx_status DROP NOT NULL,
+    ALTER COLUMN block_hash DROP NOT NULL,
+    ALTER COLUMN block_number DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- partial index for the receipt refresher, that polls only the pending transactions
+CREATE INDEX IF NOT EXISTS idx_transactions_pending ON transactions (tx_hash) WHERE pending;
@@ -0,0 +1,2 @@
+-- the reorg watcher re-validates only the most recent (not yet confirmed) mined transactions
+CREATE INDEX IF NOT EXISTS idx_transactions_block_number ON transactions (block_number) WHERE NOT pending;
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_transactions_block_number;
+CREATE INDEX IF NOT EXISTS idx_transactions_block_number ON transactions (block_number) WHERE NOT pending;
+
+-- only the transactions of the default chain (Sepolia) can be kept with a single chain key
+DELETE FROM transactions WHERE chain_id <> 11155111;
+
+ALTER TABLE user_transactions DROP CONSTRAINT IF EXISTS user_transactions_chain_id_tx_hash_fkey;
+ALTER TABLE user_transactions DROP CONSTRAINT IF EXISTS user_transactions_pkey;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_pkey;
+
+ALTER TABLE user_transactions DROP COLUMN IF EXISTS chain_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS chain_id;
+
+ALTER TABLE transactions ADD PRIMARY KEY (tx_hash);
+ALTER TABLE user_transactions ADD PRIMARY KEY (user_id, tx_hash);
+ALTER TABLE user_transactions ADD CONSTRAINT user_transactions_tx_hash_fkey
+    FOREIGN KEY (tx_hash) REFERENCES transactions (tx_hash) ON DELETE CASCADE;
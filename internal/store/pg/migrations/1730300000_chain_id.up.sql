@@ -0,0 +1,18 @@
+-- transactions are keyed by chain, since the same hash might exist on different chains;
+-- the already stored transactions were fetched from Sepolia, the only chain supported so far
+ALTER TABLE user_transactions DROP CONSTRAINT IF EXISTS user_transactions_tx_hash_fkey;
+ALTER TABLE user_transactions DROP CONSTRAINT IF EXISTS user_transactions_pkey;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_pkey;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS chain_id BIGINT NOT NULL DEFAULT 11155111;
+ALTER TABLE transactions ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE transactions ADD PRIMARY KEY (chain_id, tx_hash);
+
+ALTER TABLE user_transactions ADD COLUMN IF NOT EXISTS chain_id BIGINT NOT NULL DEFAULT 11155111;
+ALTER TABLE user_transactions ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE user_transactions ADD PRIMARY KEY (user_id, chain_id, tx_hash);
+ALTER TABLE user_transactions ADD CONSTRAINT user_transactions_chain_id_tx_hash_fkey
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_transactions_block_number;
+CREATE INDEX IF NOT EXISTS idx_transactions_block_number ON transactions (chain_id, block_number) WHERE NOT pending;
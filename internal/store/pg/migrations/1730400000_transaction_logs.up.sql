@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS transaction_logs
+(
+    chain_id  BIGINT      NOT NULL,
+    tx_hash   VARCHAR(66) NOT NULL,
+    log_index INT         NOT NULL,
+    address   VARCHAR(42) NOT NULL,
+    topics    TEXT[]      NOT NULL,
+    data      TEXT        NOT NULL,
+    removed   BOOLEAN     NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (chain_id, tx_hash, log_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS contracts
+(
+    chain_id BIGINT      NOT NULL,
+    address  VARCHAR(42) NOT NULL,
+    abi      TEXT        NOT NULL,
+    PRIMARY KEY (chain_id, address)
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS token_transfers
+(
+    chain_id      BIGINT      NOT NULL,
+    tx_hash       VARCHAR(66) NOT NULL,
+    log_index     INT         NOT NULL,
+    -- position of the transfer within TransferBatch event, 0 for the other events
+    batch_index   INT         NOT NULL DEFAULT 0,
+    standard      VARCHAR(8)  NOT NULL,
+    token_address VARCHAR(42) NOT NULL,
+    operator      VARCHAR(42),
+    from_address  VARCHAR(42) NOT NULL,
+    to_address    VARCHAR(42) NOT NULL,
+    token_id      TEXT,
+    amount        TEXT        NOT NULL,
+    PRIMARY KEY (chain_id, tx_hash, log_index, batch_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
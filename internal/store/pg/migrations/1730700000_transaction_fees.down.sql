@@ -0,0 +1,13 @@
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS nonce,
+    DROP COLUMN IF EXISTS tx_type,
+    DROP COLUMN IF EXISTS gas_limit,
+    DROP COLUMN IF EXISTS gas_price,
+    DROP COLUMN IF EXISTS max_fee_per_gas,
+    DROP COLUMN IF EXISTS max_priority_fee_per_gas,
+    DROP COLUMN IF EXISTS max_fee_per_blob_gas,
+    DROP COLUMN IF EXISTS access_list,
+    DROP COLUMN IF EXISTS gas_used,
+    DROP COLUMN IF EXISTS effective_gas_price,
+    DROP COLUMN IF EXISTS blob_gas_used,
+    DROP COLUMN IF EXISTS blob_gas_price;
@@ -0,0 +1,15 @@
+-- the columns are nullable, since the transactions stored before are missing them
+-- and the receipt ones are not known while the transaction is pending
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS nonce                    BIGINT,
+    ADD COLUMN IF NOT EXISTS tx_type                  INT,
+    ADD COLUMN IF NOT EXISTS gas_limit                BIGINT,
+    ADD COLUMN IF NOT EXISTS gas_price                TEXT,
+    ADD COLUMN IF NOT EXISTS max_fee_per_gas          TEXT,
+    ADD COLUMN IF NOT EXISTS max_priority_fee_per_gas TEXT,
+    ADD COLUMN IF NOT EXISTS max_fee_per_blob_gas     TEXT,
+    ADD COLUMN IF NOT EXISTS access_list              JSONB,
+    ADD COLUMN IF NOT EXISTS gas_used                 BIGINT,
+    ADD COLUMN IF NOT EXISTS effective_gas_price      TEXT,
+    ADD COLUMN IF NOT EXISTS blob_gas_used            BIGINT,
+    ADD COLUMN IF NOT EXISTS blob_gas_price           TEXT;
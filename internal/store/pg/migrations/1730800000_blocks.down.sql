@@ -0,0 +1,4 @@
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS transactions_block_fkey;
+
+DROP TABLE IF EXISTS blocks;
@@ -0,0 +1,19 @@
+-- the blocks are immutable by their hash, so the ones orphaned by a reorg are kept as they are
+CREATE TABLE IF NOT EXISTS blocks
+(
+    chain_id         BIGINT      NOT NULL,
+    block_hash       VARCHAR(66) NOT NULL,
+    block_number     NUMERIC     NOT NULL,
+    parent_hash      VARCHAR(66) NOT NULL,
+    timestamp        TIMESTAMPTZ NOT NULL,
+    base_fee_per_gas TEXT, -- missing before London
+    miner            VARCHAR(42) NOT NULL,
+    PRIMARY KEY (chain_id, block_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blocks_timestamp ON blocks (chain_id, timestamp);
+
+-- the transactions stored before don't have their block, so they are not validated against it
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_block_fkey FOREIGN KEY (chain_id, block_hash)
+        REFERENCES blocks (chain_id, block_hash) NOT VALID;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS watched_addresses;
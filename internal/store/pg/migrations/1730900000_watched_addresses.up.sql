@@ -0,0 +1,11 @@
+-- the addresses are stored in lower case, the ingested blocks are matched against them
+CREATE TABLE IF NOT EXISTS watched_addresses
+(
+    user_id  INT         NOT NULL,
+    chain_id BIGINT      NOT NULL,
+    address  VARCHAR(42) NOT NULL,
+    PRIMARY KEY (user_id, chain_id, address),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_watched_addresses_chain_id ON watched_addresses (chain_id);
@@ -0,0 +1,11 @@
+-- progress of the backfill runs, the job identifies the block range and the address filter of the run
+CREATE TABLE IF NOT EXISTS backfill_checkpoints
+(
+    chain_id   BIGINT      NOT NULL,
+    job        VARCHAR(64) NOT NULL,
+    from_block BIGINT      NOT NULL,
+    to_block   BIGINT      NOT NULL,
+    next_block BIGINT      NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain_id, job)
+);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS transaction_traces
+(
+    chain_id     BIGINT      NOT NULL,
+    tx_hash      VARCHAR(66) NOT NULL,
+    trace_index  INT         NOT NULL,
+    call_type    VARCHAR(16) NOT NULL,
+    from_address VARCHAR(42) NOT NULL,
+    to_address   VARCHAR(42),
+    value        TEXT        NOT NULL,
+    gas          BIGINT      NOT NULL,
+    error        TEXT,
+    depth        INT         NOT NULL,
+    PRIMARY KEY (chain_id, tx_hash, trace_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+-- the transactions, whose receipt is verified against the roots of their block header
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS verified BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- the transactions are listed page by page, ordered by block number and hash within the chain
+CREATE INDEX IF NOT EXISTS idx_transactions_keyset ON transactions (chain_id, block_number, tx_hash);
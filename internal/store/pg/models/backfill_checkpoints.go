@@ -0,0 +1,1045 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BackfillCheckpoint is an object representing the database table.
+type BackfillCheckpoint struct {
+	ChainID   int64     `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	Job       string    `boil:"job" json:"job" toml:"job" yaml:"job"`
+	FromBlock int64     `boil:"from_block" json:"from_block" toml:"from_block" yaml:"from_block"`
+	ToBlock   int64     `boil:"to_block" json:"to_block" toml:"to_block" yaml:"to_block"`
+	NextBlock int64     `boil:"next_block" json:"next_block" toml:"next_block" yaml:"next_block"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *backfillCheckpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L backfillCheckpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BackfillCheckpointColumns = struct {
+	ChainID   string
+	Job       string
+	FromBlock string
+	ToBlock   string
+	NextBlock string
+	UpdatedAt string
+}{
+	ChainID:   "chain_id",
+	Job:       "job",
+	FromBlock: "from_block",
+	ToBlock:   "to_block",
+	NextBlock: "next_block",
+	UpdatedAt: "updated_at",
+}
+
+var BackfillCheckpointTableColumns = struct {
+	ChainID   string
+	Job       string
+	FromBlock string
+	ToBlock   string
+	NextBlock string
+	UpdatedAt string
+}{
+	ChainID:   "backfill_checkpoints.chain_id",
+	Job:       "backfill_checkpoints.job",
+	FromBlock: "backfill_checkpoints.from_block",
+	ToBlock:   "backfill_checkpoints.to_block",
+	NextBlock: "backfill_checkpoints.next_block",
+	UpdatedAt: "backfill_checkpoints.updated_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var BackfillCheckpointWhere = struct {
+	ChainID   whereHelperint64
+	Job       whereHelperstring
+	FromBlock whereHelperint64
+	ToBlock   whereHelperint64
+	NextBlock whereHelperint64
+	UpdatedAt whereHelpertime_Time
+}{
+	ChainID:   whereHelperint64{field: "\"backfill_checkpoints\".\"chain_id\""},
+	Job:       whereHelperstring{field: "\"backfill_checkpoints\".\"job\""},
+	FromBlock: whereHelperint64{field: "\"backfill_checkpoints\".\"from_block\""},
+	ToBlock:   whereHelperint64{field: "\"backfill_checkpoints\".\"to_block\""},
+	NextBlock: whereHelperint64{field: "\"backfill_checkpoints\".\"next_block\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"backfill_checkpoints\".\"updated_at\""},
+}
+
+// BackfillCheckpointRels is where relationship names are stored.
+var BackfillCheckpointRels = struct {
+}{}
+
+// backfillCheckpointR is where relationships are stored.
+type backfillCheckpointR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*backfillCheckpointR) NewStruct() *backfillCheckpointR {
+	return &backfillCheckpointR{}
+}
+
+// backfillCheckpointL is where Load methods for each relationship are stored.
+type backfillCheckpointL struct{}
+
+var (
+	backfillCheckpointAllColumns            = []string{"chain_id", "job", "from_block", "to_block", "next_block", "updated_at"}
+	backfillCheckpointColumnsWithoutDefault = []string{"chain_id", "job", "from_block", "to_block", "next_block"}
+	backfillCheckpointColumnsWithDefault    = []string{"updated_at"}
+	backfillCheckpointPrimaryKeyColumns     = []string{"chain_id", "job"}
+	backfillCheckpointGeneratedColumns      = []string{}
+)
+
+type (
+	// BackfillCheckpointSlice is an alias for a slice of pointers to BackfillCheckpoint.
+	// This should almost always be used instead of []BackfillCheckpoint.
+	BackfillCheckpointSlice []*BackfillCheckpoint
+	// BackfillCheckpointHook is the signature for custom BackfillCheckpoint hook methods
+	BackfillCheckpointHook func(context.Context, boil.ContextExecutor, *BackfillCheckpoint) error
+
+	backfillCheckpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	backfillCheckpointType                 = reflect.TypeOf(&BackfillCheckpoint{})
+	backfillCheckpointMapping              = queries.MakeStructMapping(backfillCheckpointType)
+	backfillCheckpointPrimaryKeyMapping, _ = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, backfillCheckpointPrimaryKeyColumns)
+	backfillCheckpointInsertCacheMut       sync.RWMutex
+	backfillCheckpointInsertCache          = make(map[string]insertCache)
+	backfillCheckpointUpdateCacheMut       sync.RWMutex
+	backfillCheckpointUpdateCache          = make(map[string]updateCache)
+	backfillCheckpointUpsertCacheMut       sync.RWMutex
+	backfillCheckpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var backfillCheckpointAfterSelectMu sync.Mutex
+var backfillCheckpointAfterSelectHooks []BackfillCheckpointHook
+
+var backfillCheckpointBeforeInsertMu sync.Mutex
+var backfillCheckpointBeforeInsertHooks []BackfillCheckpointHook
+var backfillCheckpointAfterInsertMu sync.Mutex
+var backfillCheckpointAfterInsertHooks []BackfillCheckpointHook
+
+var backfillCheckpointBeforeUpdateMu sync.Mutex
+var backfillCheckpointBeforeUpdateHooks []BackfillCheckpointHook
+var backfillCheckpointAfterUpdateMu sync.Mutex
+var backfillCheckpointAfterUpdateHooks []BackfillCheckpointHook
+
+var backfillCheckpointBeforeDeleteMu sync.Mutex
+var backfillCheckpointBeforeDeleteHooks []BackfillCheckpointHook
+var backfillCheckpointAfterDeleteMu sync.Mutex
+var backfillCheckpointAfterDeleteHooks []BackfillCheckpointHook
+
+var backfillCheckpointBeforeUpsertMu sync.Mutex
+var backfillCheckpointBeforeUpsertHooks []BackfillCheckpointHook
+var backfillCheckpointAfterUpsertMu sync.Mutex
+var backfillCheckpointAfterUpsertHooks []BackfillCheckpointHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BackfillCheckpoint) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BackfillCheckpoint) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BackfillCheckpoint) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BackfillCheckpoint) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BackfillCheckpoint) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BackfillCheckpoint) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BackfillCheckpoint) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BackfillCheckpoint) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BackfillCheckpoint) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backfillCheckpointAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBackfillCheckpointHook registers your hook function for all future operations.
+func AddBackfillCheckpointHook(hookPoint boil.HookPoint, backfillCheckpointHook BackfillCheckpointHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		backfillCheckpointAfterSelectMu.Lock()
+		backfillCheckpointAfterSelectHooks = append(backfillCheckpointAfterSelectHooks, backfillCheckpointHook)
+		backfillCheckpointAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		backfillCheckpointBeforeInsertMu.Lock()
+		backfillCheckpointBeforeInsertHooks = append(backfillCheckpointBeforeInsertHooks, backfillCheckpointHook)
+		backfillCheckpointBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		backfillCheckpointAfterInsertMu.Lock()
+		backfillCheckpointAfterInsertHooks = append(backfillCheckpointAfterInsertHooks, backfillCheckpointHook)
+		backfillCheckpointAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		backfillCheckpointBeforeUpdateMu.Lock()
+		backfillCheckpointBeforeUpdateHooks = append(backfillCheckpointBeforeUpdateHooks, backfillCheckpointHook)
+		backfillCheckpointBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		backfillCheckpointAfterUpdateMu.Lock()
+		backfillCheckpointAfterUpdateHooks = append(backfillCheckpointAfterUpdateHooks, backfillCheckpointHook)
+		backfillCheckpointAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		backfillCheckpointBeforeDeleteMu.Lock()
+		backfillCheckpointBeforeDeleteHooks = append(backfillCheckpointBeforeDeleteHooks, backfillCheckpointHook)
+		backfillCheckpointBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		backfillCheckpointAfterDeleteMu.Lock()
+		backfillCheckpointAfterDeleteHooks = append(backfillCheckpointAfterDeleteHooks, backfillCheckpointHook)
+		backfillCheckpointAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		backfillCheckpointBeforeUpsertMu.Lock()
+		backfillCheckpointBeforeUpsertHooks = append(backfillCheckpointBeforeUpsertHooks, backfillCheckpointHook)
+		backfillCheckpointBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		backfillCheckpointAfterUpsertMu.Lock()
+		backfillCheckpointAfterUpsertHooks = append(backfillCheckpointAfterUpsertHooks, backfillCheckpointHook)
+		backfillCheckpointAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single backfillCheckpoint record from the query.
+func (q backfillCheckpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BackfillCheckpoint, error) {
+	o := &BackfillCheckpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for backfill_checkpoints")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BackfillCheckpoint records from the query.
+func (q backfillCheckpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (BackfillCheckpointSlice, error) {
+	var o []*BackfillCheckpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BackfillCheckpoint slice")
+	}
+
+	if len(backfillCheckpointAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BackfillCheckpoint records in the query.
+func (q backfillCheckpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count backfill_checkpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q backfillCheckpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if backfill_checkpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// BackfillCheckpoints retrieves all the records using an executor.
+func BackfillCheckpoints(mods ...qm.QueryMod) backfillCheckpointQuery {
+	mods = append(mods, qm.From("\"backfill_checkpoints\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"backfill_checkpoints\".*"})
+	}
+
+	return backfillCheckpointQuery{q}
+}
+
+// FindBackfillCheckpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBackfillCheckpoint(ctx context.Context, exec boil.ContextExecutor, chainID int64, job string, selectCols ...string) (*BackfillCheckpoint, error) {
+	backfillCheckpointObj := &BackfillCheckpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"backfill_checkpoints\" where \"chain_id\"=$1 AND \"job\"=$2", sel,
+	)
+
+	q := queries.Raw(query, chainID, job)
+
+	err := q.Bind(ctx, exec, backfillCheckpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from backfill_checkpoints")
+	}
+
+	if err = backfillCheckpointObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return backfillCheckpointObj, err
+	}
+
+	return backfillCheckpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BackfillCheckpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no backfill_checkpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(backfillCheckpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	backfillCheckpointInsertCacheMut.RLock()
+	cache, cached := backfillCheckpointInsertCache[key]
+	backfillCheckpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			backfillCheckpointAllColumns,
+			backfillCheckpointColumnsWithDefault,
+			backfillCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"backfill_checkpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"backfill_checkpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into backfill_checkpoints")
+	}
+
+	if !cached {
+		backfillCheckpointInsertCacheMut.Lock()
+		backfillCheckpointInsertCache[key] = cache
+		backfillCheckpointInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BackfillCheckpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BackfillCheckpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	backfillCheckpointUpdateCacheMut.RLock()
+	cache, cached := backfillCheckpointUpdateCache[key]
+	backfillCheckpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			backfillCheckpointAllColumns,
+			backfillCheckpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update backfill_checkpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"backfill_checkpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, backfillCheckpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, append(wl, backfillCheckpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update backfill_checkpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for backfill_checkpoints")
+	}
+
+	if !cached {
+		backfillCheckpointUpdateCacheMut.Lock()
+		backfillCheckpointUpdateCache[key] = cache
+		backfillCheckpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q backfillCheckpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for backfill_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for backfill_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BackfillCheckpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backfillCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"backfill_checkpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, backfillCheckpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in backfillCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all backfillCheckpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BackfillCheckpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no backfill_checkpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(backfillCheckpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	backfillCheckpointUpsertCacheMut.RLock()
+	cache, cached := backfillCheckpointUpsertCache[key]
+	backfillCheckpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			backfillCheckpointAllColumns,
+			backfillCheckpointColumnsWithDefault,
+			backfillCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			backfillCheckpointAllColumns,
+			backfillCheckpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert backfill_checkpoints, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(backfillCheckpointAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(backfillCheckpointPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert backfill_checkpoints, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(backfillCheckpointPrimaryKeyColumns))
+			copy(conflict, backfillCheckpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"backfill_checkpoints\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(backfillCheckpointType, backfillCheckpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert backfill_checkpoints")
+	}
+
+	if !cached {
+		backfillCheckpointUpsertCacheMut.Lock()
+		backfillCheckpointUpsertCache[key] = cache
+		backfillCheckpointUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BackfillCheckpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BackfillCheckpoint) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BackfillCheckpoint provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), backfillCheckpointPrimaryKeyMapping)
+	sql := "DELETE FROM \"backfill_checkpoints\" WHERE \"chain_id\"=$1 AND \"job\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from backfill_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for backfill_checkpoints")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q backfillCheckpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no backfillCheckpointQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from backfill_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for backfill_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BackfillCheckpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(backfillCheckpointBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backfillCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"backfill_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, backfillCheckpointPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from backfillCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for backfill_checkpoints")
+	}
+
+	if len(backfillCheckpointAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BackfillCheckpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBackfillCheckpoint(ctx, exec, o.ChainID, o.Job)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BackfillCheckpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BackfillCheckpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backfillCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"backfill_checkpoints\".* FROM \"backfill_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, backfillCheckpointPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BackfillCheckpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BackfillCheckpointExists checks if the BackfillCheckpoint row exists.
+func BackfillCheckpointExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, job string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"backfill_checkpoints\" where \"chain_id\"=$1 AND \"job\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, job)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, job)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if backfill_checkpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BackfillCheckpoint row exists.
+func (o *BackfillCheckpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BackfillCheckpointExists(ctx, exec, o.ChainID, o.Job)
+}
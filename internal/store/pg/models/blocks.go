@@ -0,0 +1,1036 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Block is an object representing the database table.
+type Block struct {
+	ChainID       int64         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	BlockHash     string        `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	BlockNumber   types.Decimal `boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	ParentHash    string        `boil:"parent_hash" json:"parent_hash" toml:"parent_hash" yaml:"parent_hash"`
+	Timestamp     time.Time     `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	BaseFeePerGas null.String   `boil:"base_fee_per_gas" json:"base_fee_per_gas,omitempty" toml:"base_fee_per_gas" yaml:"base_fee_per_gas,omitempty"`
+	Miner         string        `boil:"miner" json:"miner" toml:"miner" yaml:"miner"`
+
+	R *blockR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L blockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BlockColumns = struct {
+	ChainID       string
+	BlockHash     string
+	BlockNumber   string
+	ParentHash    string
+	Timestamp     string
+	BaseFeePerGas string
+	Miner         string
+}{
+	ChainID:       "chain_id",
+	BlockHash:     "block_hash",
+	BlockNumber:   "block_number",
+	ParentHash:    "parent_hash",
+	Timestamp:     "timestamp",
+	BaseFeePerGas: "base_fee_per_gas",
+	Miner:         "miner",
+}
+
+var BlockTableColumns = struct {
+	ChainID       string
+	BlockHash     string
+	BlockNumber   string
+	ParentHash    string
+	Timestamp     string
+	BaseFeePerGas string
+	Miner         string
+}{
+	ChainID:       "blocks.chain_id",
+	BlockHash:     "blocks.block_hash",
+	BlockNumber:   "blocks.block_number",
+	ParentHash:    "blocks.parent_hash",
+	Timestamp:     "blocks.timestamp",
+	BaseFeePerGas: "blocks.base_fee_per_gas",
+	Miner:         "blocks.miner",
+}
+
+// Generated where
+
+type whereHelpertypes_Decimal struct{ field string }
+
+func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Decimal) NEQ(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Decimal) LT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Decimal) LTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Decimal) GT(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var BlockWhere = struct {
+	ChainID       whereHelperint64
+	BlockHash     whereHelperstring
+	BlockNumber   whereHelpertypes_Decimal
+	ParentHash    whereHelperstring
+	Timestamp     whereHelpertime_Time
+	BaseFeePerGas whereHelpernull_String
+	Miner         whereHelperstring
+}{
+	ChainID:       whereHelperint64{field: "\"blocks\".\"chain_id\""},
+	BlockHash:     whereHelperstring{field: "\"blocks\".\"block_hash\""},
+	BlockNumber:   whereHelpertypes_Decimal{field: "\"blocks\".\"block_number\""},
+	ParentHash:    whereHelperstring{field: "\"blocks\".\"parent_hash\""},
+	Timestamp:     whereHelpertime_Time{field: "\"blocks\".\"timestamp\""},
+	BaseFeePerGas: whereHelpernull_String{field: "\"blocks\".\"base_fee_per_gas\""},
+	Miner:         whereHelperstring{field: "\"blocks\".\"miner\""},
+}
+
+// BlockRels is where relationship names are stored.
+var BlockRels = struct {
+}{}
+
+// blockR is where relationships are stored.
+type blockR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*blockR) NewStruct() *blockR {
+	return &blockR{}
+}
+
+// blockL is where Load methods for each relationship are stored.
+type blockL struct{}
+
+var (
+	blockAllColumns            = []string{"chain_id", "block_hash", "block_number", "parent_hash", "timestamp", "base_fee_per_gas", "miner"}
+	blockColumnsWithoutDefault = []string{"chain_id", "block_hash", "block_number", "parent_hash", "timestamp", "miner"}
+	blockColumnsWithDefault    = []string{"base_fee_per_gas"}
+	blockPrimaryKeyColumns     = []string{"chain_id", "block_hash"}
+	blockGeneratedColumns      = []string{}
+)
+
+type (
+	// BlockSlice is an alias for a slice of pointers to Block.
+	// This should almost always be used instead of []Block.
+	BlockSlice []*Block
+	// BlockHook is the signature for custom Block hook methods
+	BlockHook func(context.Context, boil.ContextExecutor, *Block) error
+
+	blockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	blockType                 = reflect.TypeOf(&Block{})
+	blockMapping              = queries.MakeStructMapping(blockType)
+	blockPrimaryKeyMapping, _ = queries.BindMapping(blockType, blockMapping, blockPrimaryKeyColumns)
+	blockInsertCacheMut       sync.RWMutex
+	blockInsertCache          = make(map[string]insertCache)
+	blockUpdateCacheMut       sync.RWMutex
+	blockUpdateCache          = make(map[string]updateCache)
+	blockUpsertCacheMut       sync.RWMutex
+	blockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var blockAfterSelectMu sync.Mutex
+var blockAfterSelectHooks []BlockHook
+
+var blockBeforeInsertMu sync.Mutex
+var blockBeforeInsertHooks []BlockHook
+var blockAfterInsertMu sync.Mutex
+var blockAfterInsertHooks []BlockHook
+
+var blockBeforeUpdateMu sync.Mutex
+var blockBeforeUpdateHooks []BlockHook
+var blockAfterUpdateMu sync.Mutex
+var blockAfterUpdateHooks []BlockHook
+
+var blockBeforeDeleteMu sync.Mutex
+var blockBeforeDeleteHooks []BlockHook
+var blockAfterDeleteMu sync.Mutex
+var blockAfterDeleteHooks []BlockHook
+
+var blockBeforeUpsertMu sync.Mutex
+var blockBeforeUpsertHooks []BlockHook
+var blockAfterUpsertMu sync.Mutex
+var blockAfterUpsertHooks []BlockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Block) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Block) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Block) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Block) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Block) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Block) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Block) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Block) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Block) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range blockAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBlockHook registers your hook function for all future operations.
+func AddBlockHook(hookPoint boil.HookPoint, blockHook BlockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		blockAfterSelectMu.Lock()
+		blockAfterSelectHooks = append(blockAfterSelectHooks, blockHook)
+		blockAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		blockBeforeInsertMu.Lock()
+		blockBeforeInsertHooks = append(blockBeforeInsertHooks, blockHook)
+		blockBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		blockAfterInsertMu.Lock()
+		blockAfterInsertHooks = append(blockAfterInsertHooks, blockHook)
+		blockAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		blockBeforeUpdateMu.Lock()
+		blockBeforeUpdateHooks = append(blockBeforeUpdateHooks, blockHook)
+		blockBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		blockAfterUpdateMu.Lock()
+		blockAfterUpdateHooks = append(blockAfterUpdateHooks, blockHook)
+		blockAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		blockBeforeDeleteMu.Lock()
+		blockBeforeDeleteHooks = append(blockBeforeDeleteHooks, blockHook)
+		blockBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		blockAfterDeleteMu.Lock()
+		blockAfterDeleteHooks = append(blockAfterDeleteHooks, blockHook)
+		blockAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		blockBeforeUpsertMu.Lock()
+		blockBeforeUpsertHooks = append(blockBeforeUpsertHooks, blockHook)
+		blockBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		blockAfterUpsertMu.Lock()
+		blockAfterUpsertHooks = append(blockAfterUpsertHooks, blockHook)
+		blockAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single block record from the query.
+func (q blockQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Block, error) {
+	o := &Block{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for blocks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Block records from the query.
+func (q blockQuery) All(ctx context.Context, exec boil.ContextExecutor) (BlockSlice, error) {
+	var o []*Block
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Block slice")
+	}
+
+	if len(blockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Block records in the query.
+func (q blockQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count blocks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q blockQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if blocks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Blocks retrieves all the records using an executor.
+func Blocks(mods ...qm.QueryMod) blockQuery {
+	mods = append(mods, qm.From("\"blocks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"blocks\".*"})
+	}
+
+	return blockQuery{q}
+}
+
+// FindBlock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBlock(ctx context.Context, exec boil.ContextExecutor, chainID int64, blockHash string, selectCols ...string) (*Block, error) {
+	blockObj := &Block{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"blocks\" where \"chain_id\"=$1 AND \"block_hash\"=$2", sel,
+	)
+
+	q := queries.Raw(query, chainID, blockHash)
+
+	err := q.Bind(ctx, exec, blockObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from blocks")
+	}
+
+	if err = blockObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return blockObj, err
+	}
+
+	return blockObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Block) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no blocks provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(blockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	blockInsertCacheMut.RLock()
+	cache, cached := blockInsertCache[key]
+	blockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			blockAllColumns,
+			blockColumnsWithDefault,
+			blockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(blockType, blockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"blocks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"blocks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into blocks")
+	}
+
+	if !cached {
+		blockInsertCacheMut.Lock()
+		blockInsertCache[key] = cache
+		blockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Block.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Block) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	blockUpdateCacheMut.RLock()
+	cache, cached := blockUpdateCache[key]
+	blockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			blockAllColumns,
+			blockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update blocks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"blocks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, blockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, append(wl, blockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update blocks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for blocks")
+	}
+
+	if !cached {
+		blockUpdateCacheMut.Lock()
+		blockUpdateCache[key] = cache
+		blockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q blockQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BlockSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"blocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, blockPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in block slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all block")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Block) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no blocks provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(blockColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	blockUpsertCacheMut.RLock()
+	cache, cached := blockUpsertCache[key]
+	blockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			blockAllColumns,
+			blockColumnsWithDefault,
+			blockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			blockAllColumns,
+			blockPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert blocks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(blockAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(blockPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert blocks, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(blockPrimaryKeyColumns))
+			copy(conflict, blockPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"blocks\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(blockType, blockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(blockType, blockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert blocks")
+	}
+
+	if !cached {
+		blockUpsertCacheMut.Lock()
+		blockUpsertCache[key] = cache
+		blockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Block record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Block) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Block provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), blockPrimaryKeyMapping)
+	sql := "DELETE FROM \"blocks\" WHERE \"chain_id\"=$1 AND \"block_hash\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for blocks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q blockQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no blockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BlockSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(blockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"blocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, blockPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from block slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for blocks")
+	}
+
+	if len(blockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Block) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBlock(ctx, exec, o.ChainID, o.BlockHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BlockSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BlockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"blocks\".* FROM \"blocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, blockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BlockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BlockExists checks if the Block row exists.
+func BlockExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, blockHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"blocks\" where \"chain_id\"=$1 AND \"block_hash\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, blockHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, blockHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if blocks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Block row exists.
+func (o *Block) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BlockExists(ctx, exec, o.ChainID, o.BlockHash)
+}
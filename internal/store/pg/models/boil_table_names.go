@@ -4,11 +4,25 @@
 package models
 
 var TableNames = struct {
-	Transactions     string
-	UserTransactions string
-	Users            string
+	BackfillCheckpoints string
+	Blocks              string
+	Contracts           string
+	TokenTransfers      string
+	TransactionLogs     string
+	TransactionTraces   string
+	Transactions        string
+	UserTransactions    string
+	Users               string
+	WatchedAddresses    string
 }{
-	Transactions:     "transactions",
-	UserTransactions: "user_transactions",
-	Users:            "users",
+	BackfillCheckpoints: "backfill_checkpoints",
+	Blocks:              "blocks",
+	Contracts:           "contracts",
+	TokenTransfers:      "token_transfers",
+	TransactionLogs:     "transaction_logs",
+	TransactionTraces:   "transaction_traces",
+	Transactions:        "transactions",
+	UserTransactions:    "user_transactions",
+	Users:               "users",
+	WatchedAddresses:    "watched_addresses",
 }
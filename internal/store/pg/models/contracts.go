@@ -0,0 +1,935 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Contract is an object representing the database table.
+type Contract struct {
+	ChainID int64  `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	Address string `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Abi     string `boil:"abi" json:"abi" toml:"abi" yaml:"abi"`
+
+	R *contractR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contractL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContractColumns = struct {
+	ChainID string
+	Address string
+	Abi     string
+}{
+	ChainID: "chain_id",
+	Address: "address",
+	Abi:     "abi",
+}
+
+var ContractTableColumns = struct {
+	ChainID string
+	Address string
+	Abi     string
+}{
+	ChainID: "contracts.chain_id",
+	Address: "contracts.address",
+	Abi:     "contracts.abi",
+}
+
+// Generated where
+
+var ContractWhere = struct {
+	ChainID whereHelperint64
+	Address whereHelperstring
+	Abi     whereHelperstring
+}{
+	ChainID: whereHelperint64{field: "\"contracts\".\"chain_id\""},
+	Address: whereHelperstring{field: "\"contracts\".\"address\""},
+	Abi:     whereHelperstring{field: "\"contracts\".\"abi\""},
+}
+
+// ContractRels is where relationship names are stored.
+var ContractRels = struct {
+}{}
+
+// contractR is where relationships are stored.
+type contractR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*contractR) NewStruct() *contractR {
+	return &contractR{}
+}
+
+// contractL is where Load methods for each relationship are stored.
+type contractL struct{}
+
+var (
+	contractAllColumns            = []string{"chain_id", "address", "abi"}
+	contractColumnsWithoutDefault = []string{"chain_id", "address", "abi"}
+	contractColumnsWithDefault    = []string{}
+	contractPrimaryKeyColumns     = []string{"chain_id", "address"}
+	contractGeneratedColumns      = []string{}
+)
+
+type (
+	// ContractSlice is an alias for a slice of pointers to Contract.
+	// This should almost always be used instead of []Contract.
+	ContractSlice []*Contract
+	// ContractHook is the signature for custom Contract hook methods
+	ContractHook func(context.Context, boil.ContextExecutor, *Contract) error
+
+	contractQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contractType                 = reflect.TypeOf(&Contract{})
+	contractMapping              = queries.MakeStructMapping(contractType)
+	contractPrimaryKeyMapping, _ = queries.BindMapping(contractType, contractMapping, contractPrimaryKeyColumns)
+	contractInsertCacheMut       sync.RWMutex
+	contractInsertCache          = make(map[string]insertCache)
+	contractUpdateCacheMut       sync.RWMutex
+	contractUpdateCache          = make(map[string]updateCache)
+	contractUpsertCacheMut       sync.RWMutex
+	contractUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contractAfterSelectMu sync.Mutex
+var contractAfterSelectHooks []ContractHook
+
+var contractBeforeInsertMu sync.Mutex
+var contractBeforeInsertHooks []ContractHook
+var contractAfterInsertMu sync.Mutex
+var contractAfterInsertHooks []ContractHook
+
+var contractBeforeUpdateMu sync.Mutex
+var contractBeforeUpdateHooks []ContractHook
+var contractAfterUpdateMu sync.Mutex
+var contractAfterUpdateHooks []ContractHook
+
+var contractBeforeDeleteMu sync.Mutex
+var contractBeforeDeleteHooks []ContractHook
+var contractAfterDeleteMu sync.Mutex
+var contractAfterDeleteHooks []ContractHook
+
+var contractBeforeUpsertMu sync.Mutex
+var contractBeforeUpsertHooks []ContractHook
+var contractAfterUpsertMu sync.Mutex
+var contractAfterUpsertHooks []ContractHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Contract) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Contract) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Contract) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Contract) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Contract) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Contract) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Contract) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Contract) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Contract) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contractAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContractHook registers your hook function for all future operations.
+func AddContractHook(hookPoint boil.HookPoint, contractHook ContractHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		contractAfterSelectMu.Lock()
+		contractAfterSelectHooks = append(contractAfterSelectHooks, contractHook)
+		contractAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		contractBeforeInsertMu.Lock()
+		contractBeforeInsertHooks = append(contractBeforeInsertHooks, contractHook)
+		contractBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		contractAfterInsertMu.Lock()
+		contractAfterInsertHooks = append(contractAfterInsertHooks, contractHook)
+		contractAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		contractBeforeUpdateMu.Lock()
+		contractBeforeUpdateHooks = append(contractBeforeUpdateHooks, contractHook)
+		contractBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		contractAfterUpdateMu.Lock()
+		contractAfterUpdateHooks = append(contractAfterUpdateHooks, contractHook)
+		contractAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		contractBeforeDeleteMu.Lock()
+		contractBeforeDeleteHooks = append(contractBeforeDeleteHooks, contractHook)
+		contractBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		contractAfterDeleteMu.Lock()
+		contractAfterDeleteHooks = append(contractAfterDeleteHooks, contractHook)
+		contractAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		contractBeforeUpsertMu.Lock()
+		contractBeforeUpsertHooks = append(contractBeforeUpsertHooks, contractHook)
+		contractBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		contractAfterUpsertMu.Lock()
+		contractAfterUpsertHooks = append(contractAfterUpsertHooks, contractHook)
+		contractAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single contract record from the query.
+func (q contractQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Contract, error) {
+	o := &Contract{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for contracts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Contract records from the query.
+func (q contractQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContractSlice, error) {
+	var o []*Contract
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Contract slice")
+	}
+
+	if len(contractAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Contract records in the query.
+func (q contractQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count contracts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contractQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if contracts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Contracts retrieves all the records using an executor.
+func Contracts(mods ...qm.QueryMod) contractQuery {
+	mods = append(mods, qm.From("\"contracts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"contracts\".*"})
+	}
+
+	return contractQuery{q}
+}
+
+// FindContract retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContract(ctx context.Context, exec boil.ContextExecutor, chainID int64, address string, selectCols ...string) (*Contract, error) {
+	contractObj := &Contract{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"contracts\" where \"chain_id\"=$1 AND \"address\"=$2", sel,
+	)
+
+	q := queries.Raw(query, chainID, address)
+
+	err := q.Bind(ctx, exec, contractObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from contracts")
+	}
+
+	if err = contractObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contractObj, err
+	}
+
+	return contractObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Contract) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no contracts provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contractColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contractInsertCacheMut.RLock()
+	cache, cached := contractInsertCache[key]
+	contractInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contractAllColumns,
+			contractColumnsWithDefault,
+			contractColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contractType, contractMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contractType, contractMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"contracts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"contracts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into contracts")
+	}
+
+	if !cached {
+		contractInsertCacheMut.Lock()
+		contractInsertCache[key] = cache
+		contractInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Contract.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Contract) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contractUpdateCacheMut.RLock()
+	cache, cached := contractUpdateCache[key]
+	contractUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contractAllColumns,
+			contractPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update contracts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"contracts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, contractPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contractType, contractMapping, append(wl, contractPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update contracts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for contracts")
+	}
+
+	if !cached {
+		contractUpdateCacheMut.Lock()
+		contractUpdateCache[key] = cache
+		contractUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contractQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for contracts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for contracts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContractSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contractPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"contracts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, contractPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in contract slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all contract")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Contract) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no contracts provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contractColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contractUpsertCacheMut.RLock()
+	cache, cached := contractUpsertCache[key]
+	contractUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			contractAllColumns,
+			contractColumnsWithDefault,
+			contractColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			contractAllColumns,
+			contractPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert contracts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(contractAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(contractPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert contracts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(contractPrimaryKeyColumns))
+			copy(conflict, contractPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"contracts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(contractType, contractMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contractType, contractMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert contracts")
+	}
+
+	if !cached {
+		contractUpsertCacheMut.Lock()
+		contractUpsertCache[key] = cache
+		contractUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Contract record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Contract) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Contract provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contractPrimaryKeyMapping)
+	sql := "DELETE FROM \"contracts\" WHERE \"chain_id\"=$1 AND \"address\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from contracts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for contracts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contractQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no contractQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contracts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contracts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContractSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contractBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contractPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"contracts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contractPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contract slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contracts")
+	}
+
+	if len(contractAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Contract) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContract(ctx, exec, o.ChainID, o.Address)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContractSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContractSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contractPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"contracts\".* FROM \"contracts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contractPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ContractSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContractExists checks if the Contract row exists.
+func ContractExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, address string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"contracts\" where \"chain_id\"=$1 AND \"address\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, address)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, address)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if contracts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Contract row exists.
+func (o *Contract) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ContractExists(ctx, exec, o.ChainID, o.Address)
+}
@@ -0,0 +1,1015 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TokenTransfer is an object representing the database table.
+type TokenTransfer struct {
+	ChainID      int64       `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	TXHash       string      `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	LogIndex     int         `boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	BatchIndex   int         `boil:"batch_index" json:"batch_index" toml:"batch_index" yaml:"batch_index"`
+	Standard     string      `boil:"standard" json:"standard" toml:"standard" yaml:"standard"`
+	TokenAddress string      `boil:"token_address" json:"token_address" toml:"token_address" yaml:"token_address"`
+	Operator     null.String `boil:"operator" json:"operator,omitempty" toml:"operator" yaml:"operator,omitempty"`
+	FromAddress  string      `boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
+	ToAddress    string      `boil:"to_address" json:"to_address" toml:"to_address" yaml:"to_address"`
+	TokenID      null.String `boil:"token_id" json:"token_id,omitempty" toml:"token_id" yaml:"token_id,omitempty"`
+	Amount       string      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+
+	R *tokenTransferR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tokenTransferL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TokenTransferColumns = struct {
+	ChainID      string
+	TXHash       string
+	LogIndex     string
+	BatchIndex   string
+	Standard     string
+	TokenAddress string
+	Operator     string
+	FromAddress  string
+	ToAddress    string
+	TokenID      string
+	Amount       string
+}{
+	ChainID:      "chain_id",
+	TXHash:       "tx_hash",
+	LogIndex:     "log_index",
+	BatchIndex:   "batch_index",
+	Standard:     "standard",
+	TokenAddress: "token_address",
+	Operator:     "operator",
+	FromAddress:  "from_address",
+	ToAddress:    "to_address",
+	TokenID:      "token_id",
+	Amount:       "amount",
+}
+
+var TokenTransferTableColumns = struct {
+	ChainID      string
+	TXHash       string
+	LogIndex     string
+	BatchIndex   string
+	Standard     string
+	TokenAddress string
+	Operator     string
+	FromAddress  string
+	ToAddress    string
+	TokenID      string
+	Amount       string
+}{
+	ChainID:      "token_transfers.chain_id",
+	TXHash:       "token_transfers.tx_hash",
+	LogIndex:     "token_transfers.log_index",
+	BatchIndex:   "token_transfers.batch_index",
+	Standard:     "token_transfers.standard",
+	TokenAddress: "token_transfers.token_address",
+	Operator:     "token_transfers.operator",
+	FromAddress:  "token_transfers.from_address",
+	ToAddress:    "token_transfers.to_address",
+	TokenID:      "token_transfers.token_id",
+	Amount:       "token_transfers.amount",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var TokenTransferWhere = struct {
+	ChainID      whereHelperint64
+	TXHash       whereHelperstring
+	LogIndex     whereHelperint
+	BatchIndex   whereHelperint
+	Standard     whereHelperstring
+	TokenAddress whereHelperstring
+	Operator     whereHelpernull_String
+	FromAddress  whereHelperstring
+	ToAddress    whereHelperstring
+	TokenID      whereHelpernull_String
+	Amount       whereHelperstring
+}{
+	ChainID:      whereHelperint64{field: "\"token_transfers\".\"chain_id\""},
+	TXHash:       whereHelperstring{field: "\"token_transfers\".\"tx_hash\""},
+	LogIndex:     whereHelperint{field: "\"token_transfers\".\"log_index\""},
+	BatchIndex:   whereHelperint{field: "\"token_transfers\".\"batch_index\""},
+	Standard:     whereHelperstring{field: "\"token_transfers\".\"standard\""},
+	TokenAddress: whereHelperstring{field: "\"token_transfers\".\"token_address\""},
+	Operator:     whereHelpernull_String{field: "\"token_transfers\".\"operator\""},
+	FromAddress:  whereHelperstring{field: "\"token_transfers\".\"from_address\""},
+	ToAddress:    whereHelperstring{field: "\"token_transfers\".\"to_address\""},
+	TokenID:      whereHelpernull_String{field: "\"token_transfers\".\"token_id\""},
+	Amount:       whereHelperstring{field: "\"token_transfers\".\"amount\""},
+}
+
+// TokenTransferRels is where relationship names are stored.
+var TokenTransferRels = struct {
+}{}
+
+// tokenTransferR is where relationships are stored.
+type tokenTransferR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tokenTransferR) NewStruct() *tokenTransferR {
+	return &tokenTransferR{}
+}
+
+// tokenTransferL is where Load methods for each relationship are stored.
+type tokenTransferL struct{}
+
+var (
+	tokenTransferAllColumns            = []string{"chain_id", "tx_hash", "log_index", "batch_index", "standard", "token_address", "operator", "from_address", "to_address", "token_id", "amount"}
+	tokenTransferColumnsWithoutDefault = []string{"chain_id", "tx_hash", "log_index", "standard", "token_address", "from_address", "to_address", "amount"}
+	tokenTransferColumnsWithDefault    = []string{"batch_index", "operator", "token_id"}
+	tokenTransferPrimaryKeyColumns     = []string{"chain_id", "tx_hash", "log_index", "batch_index"}
+	tokenTransferGeneratedColumns      = []string{}
+)
+
+type (
+	// TokenTransferSlice is an alias for a slice of pointers to TokenTransfer.
+	// This should almost always be used instead of []TokenTransfer.
+	TokenTransferSlice []*TokenTransfer
+	// TokenTransferHook is the signature for custom TokenTransfer hook methods
+	TokenTransferHook func(context.Context, boil.ContextExecutor, *TokenTransfer) error
+
+	tokenTransferQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tokenTransferType                 = reflect.TypeOf(&TokenTransfer{})
+	tokenTransferMapping              = queries.MakeStructMapping(tokenTransferType)
+	tokenTransferPrimaryKeyMapping, _ = queries.BindMapping(tokenTransferType, tokenTransferMapping, tokenTransferPrimaryKeyColumns)
+	tokenTransferInsertCacheMut       sync.RWMutex
+	tokenTransferInsertCache          = make(map[string]insertCache)
+	tokenTransferUpdateCacheMut       sync.RWMutex
+	tokenTransferUpdateCache          = make(map[string]updateCache)
+	tokenTransferUpsertCacheMut       sync.RWMutex
+	tokenTransferUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tokenTransferAfterSelectMu sync.Mutex
+var tokenTransferAfterSelectHooks []TokenTransferHook
+
+var tokenTransferBeforeInsertMu sync.Mutex
+var tokenTransferBeforeInsertHooks []TokenTransferHook
+var tokenTransferAfterInsertMu sync.Mutex
+var tokenTransferAfterInsertHooks []TokenTransferHook
+
+var tokenTransferBeforeUpdateMu sync.Mutex
+var tokenTransferBeforeUpdateHooks []TokenTransferHook
+var tokenTransferAfterUpdateMu sync.Mutex
+var tokenTransferAfterUpdateHooks []TokenTransferHook
+
+var tokenTransferBeforeDeleteMu sync.Mutex
+var tokenTransferBeforeDeleteHooks []TokenTransferHook
+var tokenTransferAfterDeleteMu sync.Mutex
+var tokenTransferAfterDeleteHooks []TokenTransferHook
+
+var tokenTransferBeforeUpsertMu sync.Mutex
+var tokenTransferBeforeUpsertHooks []TokenTransferHook
+var tokenTransferAfterUpsertMu sync.Mutex
+var tokenTransferAfterUpsertHooks []TokenTransferHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TokenTransfer) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TokenTransfer) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TokenTransfer) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TokenTransfer) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TokenTransfer) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TokenTransfer) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TokenTransfer) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TokenTransfer) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TokenTransfer) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tokenTransferAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTokenTransferHook registers your hook function for all future operations.
+func AddTokenTransferHook(hookPoint boil.HookPoint, tokenTransferHook TokenTransferHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tokenTransferAfterSelectMu.Lock()
+		tokenTransferAfterSelectHooks = append(tokenTransferAfterSelectHooks, tokenTransferHook)
+		tokenTransferAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tokenTransferBeforeInsertMu.Lock()
+		tokenTransferBeforeInsertHooks = append(tokenTransferBeforeInsertHooks, tokenTransferHook)
+		tokenTransferBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tokenTransferAfterInsertMu.Lock()
+		tokenTransferAfterInsertHooks = append(tokenTransferAfterInsertHooks, tokenTransferHook)
+		tokenTransferAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tokenTransferBeforeUpdateMu.Lock()
+		tokenTransferBeforeUpdateHooks = append(tokenTransferBeforeUpdateHooks, tokenTransferHook)
+		tokenTransferBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tokenTransferAfterUpdateMu.Lock()
+		tokenTransferAfterUpdateHooks = append(tokenTransferAfterUpdateHooks, tokenTransferHook)
+		tokenTransferAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tokenTransferBeforeDeleteMu.Lock()
+		tokenTransferBeforeDeleteHooks = append(tokenTransferBeforeDeleteHooks, tokenTransferHook)
+		tokenTransferBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tokenTransferAfterDeleteMu.Lock()
+		tokenTransferAfterDeleteHooks = append(tokenTransferAfterDeleteHooks, tokenTransferHook)
+		tokenTransferAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tokenTransferBeforeUpsertMu.Lock()
+		tokenTransferBeforeUpsertHooks = append(tokenTransferBeforeUpsertHooks, tokenTransferHook)
+		tokenTransferBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tokenTransferAfterUpsertMu.Lock()
+		tokenTransferAfterUpsertHooks = append(tokenTransferAfterUpsertHooks, tokenTransferHook)
+		tokenTransferAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tokenTransfer record from the query.
+func (q tokenTransferQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TokenTransfer, error) {
+	o := &TokenTransfer{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for token_transfers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TokenTransfer records from the query.
+func (q tokenTransferQuery) All(ctx context.Context, exec boil.ContextExecutor) (TokenTransferSlice, error) {
+	var o []*TokenTransfer
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TokenTransfer slice")
+	}
+
+	if len(tokenTransferAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TokenTransfer records in the query.
+func (q tokenTransferQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count token_transfers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tokenTransferQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if token_transfers exists")
+	}
+
+	return count > 0, nil
+}
+
+// TokenTransfers retrieves all the records using an executor.
+func TokenTransfers(mods ...qm.QueryMod) tokenTransferQuery {
+	mods = append(mods, qm.From("\"token_transfers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"token_transfers\".*"})
+	}
+
+	return tokenTransferQuery{q}
+}
+
+// FindTokenTransfer retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTokenTransfer(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, logIndex int, batchIndex int, selectCols ...string) (*TokenTransfer, error) {
+	tokenTransferObj := &TokenTransfer{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"token_transfers\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3 AND \"batch_index\"=$4", sel,
+	)
+
+	q := queries.Raw(query, chainID, tXHash, logIndex, batchIndex)
+
+	err := q.Bind(ctx, exec, tokenTransferObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from token_transfers")
+	}
+
+	if err = tokenTransferObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tokenTransferObj, err
+	}
+
+	return tokenTransferObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TokenTransfer) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no token_transfers provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenTransferColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tokenTransferInsertCacheMut.RLock()
+	cache, cached := tokenTransferInsertCache[key]
+	tokenTransferInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tokenTransferAllColumns,
+			tokenTransferColumnsWithDefault,
+			tokenTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tokenTransferType, tokenTransferMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tokenTransferType, tokenTransferMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"token_transfers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"token_transfers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into token_transfers")
+	}
+
+	if !cached {
+		tokenTransferInsertCacheMut.Lock()
+		tokenTransferInsertCache[key] = cache
+		tokenTransferInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TokenTransfer.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TokenTransfer) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tokenTransferUpdateCacheMut.RLock()
+	cache, cached := tokenTransferUpdateCache[key]
+	tokenTransferUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tokenTransferAllColumns,
+			tokenTransferPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update token_transfers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"token_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, tokenTransferPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tokenTransferType, tokenTransferMapping, append(wl, tokenTransferPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update token_transfers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for token_transfers")
+	}
+
+	if !cached {
+		tokenTransferUpdateCacheMut.Lock()
+		tokenTransferUpdateCache[key] = cache
+		tokenTransferUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tokenTransferQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for token_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for token_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TokenTransferSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"token_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, tokenTransferPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in tokenTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all tokenTransfer")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TokenTransfer) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no token_transfers provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tokenTransferColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tokenTransferUpsertCacheMut.RLock()
+	cache, cached := tokenTransferUpsertCache[key]
+	tokenTransferUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tokenTransferAllColumns,
+			tokenTransferColumnsWithDefault,
+			tokenTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tokenTransferAllColumns,
+			tokenTransferPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert token_transfers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tokenTransferAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(tokenTransferPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert token_transfers, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(tokenTransferPrimaryKeyColumns))
+			copy(conflict, tokenTransferPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"token_transfers\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(tokenTransferType, tokenTransferMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tokenTransferType, tokenTransferMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert token_transfers")
+	}
+
+	if !cached {
+		tokenTransferUpsertCacheMut.Lock()
+		tokenTransferUpsertCache[key] = cache
+		tokenTransferUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TokenTransfer record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TokenTransfer) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TokenTransfer provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tokenTransferPrimaryKeyMapping)
+	sql := "DELETE FROM \"token_transfers\" WHERE \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3 AND \"batch_index\"=$4"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from token_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for token_transfers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tokenTransferQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no tokenTransferQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from token_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for token_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TokenTransferSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tokenTransferBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"token_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tokenTransferPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from tokenTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for token_transfers")
+	}
+
+	if len(tokenTransferAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TokenTransfer) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTokenTransfer(ctx, exec, o.ChainID, o.TXHash, o.LogIndex, o.BatchIndex)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TokenTransferSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TokenTransferSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tokenTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"token_transfers\".* FROM \"token_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, tokenTransferPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TokenTransferSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TokenTransferExists checks if the TokenTransfer row exists.
+func TokenTransferExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, logIndex int, batchIndex int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"token_transfers\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3 AND \"batch_index\"=$4 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, tXHash, logIndex, batchIndex)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, tXHash, logIndex, batchIndex)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if token_transfers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TokenTransfer row exists.
+func (o *TokenTransfer) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TokenTransferExists(ctx, exec, o.ChainID, o.TXHash, o.LogIndex, o.BatchIndex)
+}
@@ -0,0 +1,994 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransactionLog is an object representing the database table.
+type TransactionLog struct {
+	ChainID  int64             `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	TXHash   string            `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	LogIndex int               `boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	Address  string            `boil:"address" json:"address" toml:"address" yaml:"address"`
+	Topics   types.StringArray `boil:"topics" json:"topics" toml:"topics" yaml:"topics"`
+	Data     string            `boil:"data" json:"data" toml:"data" yaml:"data"`
+	Removed  bool              `boil:"removed" json:"removed" toml:"removed" yaml:"removed"`
+
+	R *transactionLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transactionLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransactionLogColumns = struct {
+	ChainID  string
+	TXHash   string
+	LogIndex string
+	Address  string
+	Topics   string
+	Data     string
+	Removed  string
+}{
+	ChainID:  "chain_id",
+	TXHash:   "tx_hash",
+	LogIndex: "log_index",
+	Address:  "address",
+	Topics:   "topics",
+	Data:     "data",
+	Removed:  "removed",
+}
+
+var TransactionLogTableColumns = struct {
+	ChainID  string
+	TXHash   string
+	LogIndex string
+	Address  string
+	Topics   string
+	Data     string
+	Removed  string
+}{
+	ChainID:  "transaction_logs.chain_id",
+	TXHash:   "transaction_logs.tx_hash",
+	LogIndex: "transaction_logs.log_index",
+	Address:  "transaction_logs.address",
+	Topics:   "transaction_logs.topics",
+	Data:     "transaction_logs.data",
+	Removed:  "transaction_logs.removed",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var TransactionLogWhere = struct {
+	ChainID  whereHelperint64
+	TXHash   whereHelperstring
+	LogIndex whereHelperint
+	Address  whereHelperstring
+	Topics   whereHelpertypes_StringArray
+	Data     whereHelperstring
+	Removed  whereHelperbool
+}{
+	ChainID:  whereHelperint64{field: "\"transaction_logs\".\"chain_id\""},
+	TXHash:   whereHelperstring{field: "\"transaction_logs\".\"tx_hash\""},
+	LogIndex: whereHelperint{field: "\"transaction_logs\".\"log_index\""},
+	Address:  whereHelperstring{field: "\"transaction_logs\".\"address\""},
+	Topics:   whereHelpertypes_StringArray{field: "\"transaction_logs\".\"topics\""},
+	Data:     whereHelperstring{field: "\"transaction_logs\".\"data\""},
+	Removed:  whereHelperbool{field: "\"transaction_logs\".\"removed\""},
+}
+
+// TransactionLogRels is where relationship names are stored.
+var TransactionLogRels = struct {
+}{}
+
+// transactionLogR is where relationships are stored.
+type transactionLogR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*transactionLogR) NewStruct() *transactionLogR {
+	return &transactionLogR{}
+}
+
+// transactionLogL is where Load methods for each relationship are stored.
+type transactionLogL struct{}
+
+var (
+	transactionLogAllColumns            = []string{"chain_id", "tx_hash", "log_index", "address", "topics", "data", "removed"}
+	transactionLogColumnsWithoutDefault = []string{"chain_id", "tx_hash", "log_index", "address", "topics", "data"}
+	transactionLogColumnsWithDefault    = []string{"removed"}
+	transactionLogPrimaryKeyColumns     = []string{"chain_id", "tx_hash", "log_index"}
+	transactionLogGeneratedColumns      = []string{}
+)
+
+type (
+	// TransactionLogSlice is an alias for a slice of pointers to TransactionLog.
+	// This should almost always be used instead of []TransactionLog.
+	TransactionLogSlice []*TransactionLog
+	// TransactionLogHook is the signature for custom TransactionLog hook methods
+	TransactionLogHook func(context.Context, boil.ContextExecutor, *TransactionLog) error
+
+	transactionLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transactionLogType                 = reflect.TypeOf(&TransactionLog{})
+	transactionLogMapping              = queries.MakeStructMapping(transactionLogType)
+	transactionLogPrimaryKeyMapping, _ = queries.BindMapping(transactionLogType, transactionLogMapping, transactionLogPrimaryKeyColumns)
+	transactionLogInsertCacheMut       sync.RWMutex
+	transactionLogInsertCache          = make(map[string]insertCache)
+	transactionLogUpdateCacheMut       sync.RWMutex
+	transactionLogUpdateCache          = make(map[string]updateCache)
+	transactionLogUpsertCacheMut       sync.RWMutex
+	transactionLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transactionLogAfterSelectMu sync.Mutex
+var transactionLogAfterSelectHooks []TransactionLogHook
+
+var transactionLogBeforeInsertMu sync.Mutex
+var transactionLogBeforeInsertHooks []TransactionLogHook
+var transactionLogAfterInsertMu sync.Mutex
+var transactionLogAfterInsertHooks []TransactionLogHook
+
+var transactionLogBeforeUpdateMu sync.Mutex
+var transactionLogBeforeUpdateHooks []TransactionLogHook
+var transactionLogAfterUpdateMu sync.Mutex
+var transactionLogAfterUpdateHooks []TransactionLogHook
+
+var transactionLogBeforeDeleteMu sync.Mutex
+var transactionLogBeforeDeleteHooks []TransactionLogHook
+var transactionLogAfterDeleteMu sync.Mutex
+var transactionLogAfterDeleteHooks []TransactionLogHook
+
+var transactionLogBeforeUpsertMu sync.Mutex
+var transactionLogBeforeUpsertHooks []TransactionLogHook
+var transactionLogAfterUpsertMu sync.Mutex
+var transactionLogAfterUpsertHooks []TransactionLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransactionLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransactionLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransactionLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransactionLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransactionLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransactionLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransactionLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransactionLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransactionLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransactionLogHook registers your hook function for all future operations.
+func AddTransactionLogHook(hookPoint boil.HookPoint, transactionLogHook TransactionLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transactionLogAfterSelectMu.Lock()
+		transactionLogAfterSelectHooks = append(transactionLogAfterSelectHooks, transactionLogHook)
+		transactionLogAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transactionLogBeforeInsertMu.Lock()
+		transactionLogBeforeInsertHooks = append(transactionLogBeforeInsertHooks, transactionLogHook)
+		transactionLogBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transactionLogAfterInsertMu.Lock()
+		transactionLogAfterInsertHooks = append(transactionLogAfterInsertHooks, transactionLogHook)
+		transactionLogAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transactionLogBeforeUpdateMu.Lock()
+		transactionLogBeforeUpdateHooks = append(transactionLogBeforeUpdateHooks, transactionLogHook)
+		transactionLogBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transactionLogAfterUpdateMu.Lock()
+		transactionLogAfterUpdateHooks = append(transactionLogAfterUpdateHooks, transactionLogHook)
+		transactionLogAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transactionLogBeforeDeleteMu.Lock()
+		transactionLogBeforeDeleteHooks = append(transactionLogBeforeDeleteHooks, transactionLogHook)
+		transactionLogBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transactionLogAfterDeleteMu.Lock()
+		transactionLogAfterDeleteHooks = append(transactionLogAfterDeleteHooks, transactionLogHook)
+		transactionLogAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transactionLogBeforeUpsertMu.Lock()
+		transactionLogBeforeUpsertHooks = append(transactionLogBeforeUpsertHooks, transactionLogHook)
+		transactionLogBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transactionLogAfterUpsertMu.Lock()
+		transactionLogAfterUpsertHooks = append(transactionLogAfterUpsertHooks, transactionLogHook)
+		transactionLogAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single transactionLog record from the query.
+func (q transactionLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransactionLog, error) {
+	o := &TransactionLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for transaction_logs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TransactionLog records from the query.
+func (q transactionLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransactionLogSlice, error) {
+	var o []*TransactionLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TransactionLog slice")
+	}
+
+	if len(transactionLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TransactionLog records in the query.
+func (q transactionLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count transaction_logs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q transactionLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if transaction_logs exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransactionLogs retrieves all the records using an executor.
+func TransactionLogs(mods ...qm.QueryMod) transactionLogQuery {
+	mods = append(mods, qm.From("\"transaction_logs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transaction_logs\".*"})
+	}
+
+	return transactionLogQuery{q}
+}
+
+// FindTransactionLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransactionLog(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, logIndex int, selectCols ...string) (*TransactionLog, error) {
+	transactionLogObj := &TransactionLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transaction_logs\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3", sel,
+	)
+
+	q := queries.Raw(query, chainID, tXHash, logIndex)
+
+	err := q.Bind(ctx, exec, transactionLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from transaction_logs")
+	}
+
+	if err = transactionLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transactionLogObj, err
+	}
+
+	return transactionLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransactionLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no transaction_logs provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transactionLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transactionLogInsertCacheMut.RLock()
+	cache, cached := transactionLogInsertCache[key]
+	transactionLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transactionLogAllColumns,
+			transactionLogColumnsWithDefault,
+			transactionLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transactionLogType, transactionLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transactionLogType, transactionLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transaction_logs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transaction_logs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into transaction_logs")
+	}
+
+	if !cached {
+		transactionLogInsertCacheMut.Lock()
+		transactionLogInsertCache[key] = cache
+		transactionLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TransactionLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransactionLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transactionLogUpdateCacheMut.RLock()
+	cache, cached := transactionLogUpdateCache[key]
+	transactionLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transactionLogAllColumns,
+			transactionLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update transaction_logs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transaction_logs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transactionLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transactionLogType, transactionLogMapping, append(wl, transactionLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update transaction_logs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for transaction_logs")
+	}
+
+	if !cached {
+		transactionLogUpdateCacheMut.Lock()
+		transactionLogUpdateCache[key] = cache
+		transactionLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transactionLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for transaction_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for transaction_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransactionLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transaction_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transactionLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in transactionLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all transactionLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransactionLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no transaction_logs provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transactionLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transactionLogUpsertCacheMut.RLock()
+	cache, cached := transactionLogUpsertCache[key]
+	transactionLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transactionLogAllColumns,
+			transactionLogColumnsWithDefault,
+			transactionLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transactionLogAllColumns,
+			transactionLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert transaction_logs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transactionLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transactionLogPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert transaction_logs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transactionLogPrimaryKeyColumns))
+			copy(conflict, transactionLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transaction_logs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transactionLogType, transactionLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transactionLogType, transactionLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert transaction_logs")
+	}
+
+	if !cached {
+		transactionLogUpsertCacheMut.Lock()
+		transactionLogUpsertCache[key] = cache
+		transactionLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TransactionLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransactionLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TransactionLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transactionLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"transaction_logs\" WHERE \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from transaction_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for transaction_logs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q transactionLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no transactionLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from transaction_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for transaction_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransactionLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transactionLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"transaction_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transactionLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from transactionLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for transaction_logs")
+	}
+
+	if len(transactionLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransactionLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransactionLog(ctx, exec, o.ChainID, o.TXHash, o.LogIndex)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransactionLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransactionLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transaction_logs\".* FROM \"transaction_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transactionLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TransactionLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransactionLogExists checks if the TransactionLog row exists.
+func TransactionLogExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, logIndex int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transaction_logs\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"log_index\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, tXHash, logIndex)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, tXHash, logIndex)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if transaction_logs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransactionLog row exists.
+func (o *TransactionLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransactionLogExists(ctx, exec, o.ChainID, o.TXHash, o.LogIndex)
+}
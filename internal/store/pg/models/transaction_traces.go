@@ -0,0 +1,985 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TransactionTrace is an object representing the database table.
+type TransactionTrace struct {
+	ChainID     int64       `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	TXHash      string      `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	TraceIndex  int         `boil:"trace_index" json:"trace_index" toml:"trace_index" yaml:"trace_index"`
+	CallType    string      `boil:"call_type" json:"call_type" toml:"call_type" yaml:"call_type"`
+	FromAddress string      `boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
+	ToAddress   null.String `boil:"to_address" json:"to_address,omitempty" toml:"to_address" yaml:"to_address,omitempty"`
+	Value       string      `boil:"value" json:"value" toml:"value" yaml:"value"`
+	Gas         int64       `boil:"gas" json:"gas" toml:"gas" yaml:"gas"`
+	Error       null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	Depth       int         `boil:"depth" json:"depth" toml:"depth" yaml:"depth"`
+
+	R *transactionTraceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transactionTraceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransactionTraceColumns = struct {
+	ChainID     string
+	TXHash      string
+	TraceIndex  string
+	CallType    string
+	FromAddress string
+	ToAddress   string
+	Value       string
+	Gas         string
+	Error       string
+	Depth       string
+}{
+	ChainID:     "chain_id",
+	TXHash:      "tx_hash",
+	TraceIndex:  "trace_index",
+	CallType:    "call_type",
+	FromAddress: "from_address",
+	ToAddress:   "to_address",
+	Value:       "value",
+	Gas:         "gas",
+	Error:       "error",
+	Depth:       "depth",
+}
+
+var TransactionTraceTableColumns = struct {
+	ChainID     string
+	TXHash      string
+	TraceIndex  string
+	CallType    string
+	FromAddress string
+	ToAddress   string
+	Value       string
+	Gas         string
+	Error       string
+	Depth       string
+}{
+	ChainID:     "transaction_traces.chain_id",
+	TXHash:      "transaction_traces.tx_hash",
+	TraceIndex:  "transaction_traces.trace_index",
+	CallType:    "transaction_traces.call_type",
+	FromAddress: "transaction_traces.from_address",
+	ToAddress:   "transaction_traces.to_address",
+	Value:       "transaction_traces.value",
+	Gas:         "transaction_traces.gas",
+	Error:       "transaction_traces.error",
+	Depth:       "transaction_traces.depth",
+}
+
+// Generated where
+
+var TransactionTraceWhere = struct {
+	ChainID     whereHelperint64
+	TXHash      whereHelperstring
+	TraceIndex  whereHelperint
+	CallType    whereHelperstring
+	FromAddress whereHelperstring
+	ToAddress   whereHelpernull_String
+	Value       whereHelperstring
+	Gas         whereHelperint64
+	Error       whereHelpernull_String
+	Depth       whereHelperint
+}{
+	ChainID:     whereHelperint64{field: "\"transaction_traces\".\"chain_id\""},
+	TXHash:      whereHelperstring{field: "\"transaction_traces\".\"tx_hash\""},
+	TraceIndex:  whereHelperint{field: "\"transaction_traces\".\"trace_index\""},
+	CallType:    whereHelperstring{field: "\"transaction_traces\".\"call_type\""},
+	FromAddress: whereHelperstring{field: "\"transaction_traces\".\"from_address\""},
+	ToAddress:   whereHelpernull_String{field: "\"transaction_traces\".\"to_address\""},
+	Value:       whereHelperstring{field: "\"transaction_traces\".\"value\""},
+	Gas:         whereHelperint64{field: "\"transaction_traces\".\"gas\""},
+	Error:       whereHelpernull_String{field: "\"transaction_traces\".\"error\""},
+	Depth:       whereHelperint{field: "\"transaction_traces\".\"depth\""},
+}
+
+// TransactionTraceRels is where relationship names are stored.
+var TransactionTraceRels = struct {
+}{}
+
+// transactionTraceR is where relationships are stored.
+type transactionTraceR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*transactionTraceR) NewStruct() *transactionTraceR {
+	return &transactionTraceR{}
+}
+
+// transactionTraceL is where Load methods for each relationship are stored.
+type transactionTraceL struct{}
+
+var (
+	transactionTraceAllColumns            = []string{"chain_id", "tx_hash", "trace_index", "call_type", "from_address", "to_address", "value", "gas", "error", "depth"}
+	transactionTraceColumnsWithoutDefault = []string{"chain_id", "tx_hash", "trace_index", "call_type", "from_address", "value", "gas", "depth"}
+	transactionTraceColumnsWithDefault    = []string{"to_address", "error"}
+	transactionTracePrimaryKeyColumns     = []string{"chain_id", "tx_hash", "trace_index"}
+	transactionTraceGeneratedColumns      = []string{}
+)
+
+type (
+	// TransactionTraceSlice is an alias for a slice of pointers to TransactionTrace.
+	// This should almost always be used instead of []TransactionTrace.
+	TransactionTraceSlice []*TransactionTrace
+	// TransactionTraceHook is the signature for custom TransactionTrace hook methods
+	TransactionTraceHook func(context.Context, boil.ContextExecutor, *TransactionTrace) error
+
+	transactionTraceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transactionTraceType                 = reflect.TypeOf(&TransactionTrace{})
+	transactionTraceMapping              = queries.MakeStructMapping(transactionTraceType)
+	transactionTracePrimaryKeyMapping, _ = queries.BindMapping(transactionTraceType, transactionTraceMapping, transactionTracePrimaryKeyColumns)
+	transactionTraceInsertCacheMut       sync.RWMutex
+	transactionTraceInsertCache          = make(map[string]insertCache)
+	transactionTraceUpdateCacheMut       sync.RWMutex
+	transactionTraceUpdateCache          = make(map[string]updateCache)
+	transactionTraceUpsertCacheMut       sync.RWMutex
+	transactionTraceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transactionTraceAfterSelectMu sync.Mutex
+var transactionTraceAfterSelectHooks []TransactionTraceHook
+
+var transactionTraceBeforeInsertMu sync.Mutex
+var transactionTraceBeforeInsertHooks []TransactionTraceHook
+var transactionTraceAfterInsertMu sync.Mutex
+var transactionTraceAfterInsertHooks []TransactionTraceHook
+
+var transactionTraceBeforeUpdateMu sync.Mutex
+var transactionTraceBeforeUpdateHooks []TransactionTraceHook
+var transactionTraceAfterUpdateMu sync.Mutex
+var transactionTraceAfterUpdateHooks []TransactionTraceHook
+
+var transactionTraceBeforeDeleteMu sync.Mutex
+var transactionTraceBeforeDeleteHooks []TransactionTraceHook
+var transactionTraceAfterDeleteMu sync.Mutex
+var transactionTraceAfterDeleteHooks []TransactionTraceHook
+
+var transactionTraceBeforeUpsertMu sync.Mutex
+var transactionTraceBeforeUpsertHooks []TransactionTraceHook
+var transactionTraceAfterUpsertMu sync.Mutex
+var transactionTraceAfterUpsertHooks []TransactionTraceHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransactionTrace) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransactionTrace) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransactionTrace) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransactionTrace) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransactionTrace) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransactionTrace) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransactionTrace) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransactionTrace) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransactionTrace) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transactionTraceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransactionTraceHook registers your hook function for all future operations.
+func AddTransactionTraceHook(hookPoint boil.HookPoint, transactionTraceHook TransactionTraceHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		transactionTraceAfterSelectMu.Lock()
+		transactionTraceAfterSelectHooks = append(transactionTraceAfterSelectHooks, transactionTraceHook)
+		transactionTraceAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		transactionTraceBeforeInsertMu.Lock()
+		transactionTraceBeforeInsertHooks = append(transactionTraceBeforeInsertHooks, transactionTraceHook)
+		transactionTraceBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		transactionTraceAfterInsertMu.Lock()
+		transactionTraceAfterInsertHooks = append(transactionTraceAfterInsertHooks, transactionTraceHook)
+		transactionTraceAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		transactionTraceBeforeUpdateMu.Lock()
+		transactionTraceBeforeUpdateHooks = append(transactionTraceBeforeUpdateHooks, transactionTraceHook)
+		transactionTraceBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		transactionTraceAfterUpdateMu.Lock()
+		transactionTraceAfterUpdateHooks = append(transactionTraceAfterUpdateHooks, transactionTraceHook)
+		transactionTraceAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		transactionTraceBeforeDeleteMu.Lock()
+		transactionTraceBeforeDeleteHooks = append(transactionTraceBeforeDeleteHooks, transactionTraceHook)
+		transactionTraceBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		transactionTraceAfterDeleteMu.Lock()
+		transactionTraceAfterDeleteHooks = append(transactionTraceAfterDeleteHooks, transactionTraceHook)
+		transactionTraceAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		transactionTraceBeforeUpsertMu.Lock()
+		transactionTraceBeforeUpsertHooks = append(transactionTraceBeforeUpsertHooks, transactionTraceHook)
+		transactionTraceBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		transactionTraceAfterUpsertMu.Lock()
+		transactionTraceAfterUpsertHooks = append(transactionTraceAfterUpsertHooks, transactionTraceHook)
+		transactionTraceAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single transactionTrace record from the query.
+func (q transactionTraceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransactionTrace, error) {
+	o := &TransactionTrace{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for transaction_traces")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TransactionTrace records from the query.
+func (q transactionTraceQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransactionTraceSlice, error) {
+	var o []*TransactionTrace
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TransactionTrace slice")
+	}
+
+	if len(transactionTraceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TransactionTrace records in the query.
+func (q transactionTraceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count transaction_traces rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q transactionTraceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if transaction_traces exists")
+	}
+
+	return count > 0, nil
+}
+
+// TransactionTraces retrieves all the records using an executor.
+func TransactionTraces(mods ...qm.QueryMod) transactionTraceQuery {
+	mods = append(mods, qm.From("\"transaction_traces\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"transaction_traces\".*"})
+	}
+
+	return transactionTraceQuery{q}
+}
+
+// FindTransactionTrace retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransactionTrace(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, traceIndex int, selectCols ...string) (*TransactionTrace, error) {
+	transactionTraceObj := &TransactionTrace{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transaction_traces\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"trace_index\"=$3", sel,
+	)
+
+	q := queries.Raw(query, chainID, tXHash, traceIndex)
+
+	err := q.Bind(ctx, exec, transactionTraceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from transaction_traces")
+	}
+
+	if err = transactionTraceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return transactionTraceObj, err
+	}
+
+	return transactionTraceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransactionTrace) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no transaction_traces provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transactionTraceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transactionTraceInsertCacheMut.RLock()
+	cache, cached := transactionTraceInsertCache[key]
+	transactionTraceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transactionTraceAllColumns,
+			transactionTraceColumnsWithDefault,
+			transactionTraceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transactionTraceType, transactionTraceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transactionTraceType, transactionTraceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transaction_traces\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transaction_traces\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into transaction_traces")
+	}
+
+	if !cached {
+		transactionTraceInsertCacheMut.Lock()
+		transactionTraceInsertCache[key] = cache
+		transactionTraceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TransactionTrace.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransactionTrace) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transactionTraceUpdateCacheMut.RLock()
+	cache, cached := transactionTraceUpdateCache[key]
+	transactionTraceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transactionTraceAllColumns,
+			transactionTracePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update transaction_traces, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transaction_traces\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, transactionTracePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transactionTraceType, transactionTraceMapping, append(wl, transactionTracePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update transaction_traces row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for transaction_traces")
+	}
+
+	if !cached {
+		transactionTraceUpdateCacheMut.Lock()
+		transactionTraceUpdateCache[key] = cache
+		transactionTraceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transactionTraceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for transaction_traces")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for transaction_traces")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransactionTraceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionTracePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transaction_traces\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, transactionTracePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in transactionTrace slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all transactionTrace")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TransactionTrace) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no transaction_traces provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transactionTraceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transactionTraceUpsertCacheMut.RLock()
+	cache, cached := transactionTraceUpsertCache[key]
+	transactionTraceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transactionTraceAllColumns,
+			transactionTraceColumnsWithDefault,
+			transactionTraceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			transactionTraceAllColumns,
+			transactionTracePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert transaction_traces, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transactionTraceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(transactionTracePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert transaction_traces, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(transactionTracePrimaryKeyColumns))
+			copy(conflict, transactionTracePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"transaction_traces\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(transactionTraceType, transactionTraceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transactionTraceType, transactionTraceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert transaction_traces")
+	}
+
+	if !cached {
+		transactionTraceUpsertCacheMut.Lock()
+		transactionTraceUpsertCache[key] = cache
+		transactionTraceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TransactionTrace record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransactionTrace) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TransactionTrace provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transactionTracePrimaryKeyMapping)
+	sql := "DELETE FROM \"transaction_traces\" WHERE \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"trace_index\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from transaction_traces")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for transaction_traces")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q transactionTraceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no transactionTraceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from transaction_traces")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for transaction_traces")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransactionTraceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transactionTraceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionTracePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"transaction_traces\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transactionTracePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from transactionTrace slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for transaction_traces")
+	}
+
+	if len(transactionTraceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransactionTrace) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransactionTrace(ctx, exec, o.ChainID, o.TXHash, o.TraceIndex)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransactionTraceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransactionTraceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transactionTracePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transaction_traces\".* FROM \"transaction_traces\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, transactionTracePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TransactionTraceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransactionTraceExists checks if the TransactionTrace row exists.
+func TransactionTraceExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, traceIndex int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transaction_traces\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 AND \"trace_index\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chainID, tXHash, traceIndex)
+	}
+	row := exec.QueryRowContext(ctx, sql, chainID, tXHash, traceIndex)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if transaction_traces exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TransactionTrace row exists.
+func (o *TransactionTrace) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TransactionTraceExists(ctx, exec, o.ChainID, o.TXHash, o.TraceIndex)
+}
@@ -25,114 +25,174 @@ import (
 
 // Transaction is an object representing the database table.
 type Transaction struct {
-	TXHash          string        `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
-	TXStatus        int           `boil:"tx_status" json:"tx_status" toml:"tx_status" yaml:"tx_status"`
-	BlockHash       string        `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
-	BlockNumber     types.Decimal `boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
-	FromAddress     string        `boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
-	ToAddress       null.String   `boil:"to_address" json:"to_address,omitempty" toml:"to_address" yaml:"to_address,omitempty"`
-	ContractAddress null.String   `boil:"contract_address" json:"contract_address,omitempty" toml:"contract_address" yaml:"contract_address,omitempty"`
-	LogsCount       int64         `boil:"logs_count" json:"logs_count" toml:"logs_count" yaml:"logs_count"`
-	Input           string        `boil:"input" json:"input" toml:"input" yaml:"input"`
-	Value           string        `boil:"value" json:"value" toml:"value" yaml:"value"`
+	TXHash               string            `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	TXStatus             null.Int          `boil:"tx_status" json:"tx_status,omitempty" toml:"tx_status" yaml:"tx_status,omitempty"`
+	BlockHash            null.String       `boil:"block_hash" json:"block_hash,omitempty" toml:"block_hash" yaml:"block_hash,omitempty"`
+	BlockNumber          types.NullDecimal `boil:"block_number" json:"block_number,omitempty" toml:"block_number" yaml:"block_number,omitempty"`
+	FromAddress          string            `boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
+	ToAddress            null.String       `boil:"to_address" json:"to_address,omitempty" toml:"to_address" yaml:"to_address,omitempty"`
+	ContractAddress      null.String       `boil:"contract_address" json:"contract_address,omitempty" toml:"contract_address" yaml:"contract_address,omitempty"`
+	LogsCount            int64             `boil:"logs_count" json:"logs_count" toml:"logs_count" yaml:"logs_count"`
+	Input                string            `boil:"input" json:"input" toml:"input" yaml:"input"`
+	Value                string            `boil:"value" json:"value" toml:"value" yaml:"value"`
+	Pending              bool              `boil:"pending" json:"pending" toml:"pending" yaml:"pending"`
+	ChainID              int64             `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	Nonce                null.Int64        `boil:"nonce" json:"nonce,omitempty" toml:"nonce" yaml:"nonce,omitempty"`
+	TXType               null.Int          `boil:"tx_type" json:"tx_type,omitempty" toml:"tx_type" yaml:"tx_type,omitempty"`
+	GasLimit             null.Int64        `boil:"gas_limit" json:"gas_limit,omitempty" toml:"gas_limit" yaml:"gas_limit,omitempty"`
+	GasPrice             null.String       `boil:"gas_price" json:"gas_price,omitempty" toml:"gas_price" yaml:"gas_price,omitempty"`
+	MaxFeePerGas         null.String       `boil:"max_fee_per_gas" json:"max_fee_per_gas,omitempty" toml:"max_fee_per_gas" yaml:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas null.String       `boil:"max_priority_fee_per_gas" json:"max_priority_fee_per_gas,omitempty" toml:"max_priority_fee_per_gas" yaml:"max_priority_fee_per_gas,omitempty"`
+	MaxFeePerBlobGas     null.String       `boil:"max_fee_per_blob_gas" json:"max_fee_per_blob_gas,omitempty" toml:"max_fee_per_blob_gas" yaml:"max_fee_per_blob_gas,omitempty"`
+	AccessList           null.JSON         `boil:"access_list" json:"access_list,omitempty" toml:"access_list" yaml:"access_list,omitempty"`
+	GasUsed              null.Int64        `boil:"gas_used" json:"gas_used,omitempty" toml:"gas_used" yaml:"gas_used,omitempty"`
+	EffectiveGasPrice    null.String       `boil:"effective_gas_price" json:"effective_gas_price,omitempty" toml:"effective_gas_price" yaml:"effective_gas_price,omitempty"`
+	BlobGasUsed          null.Int64        `boil:"blob_gas_used" json:"blob_gas_used,omitempty" toml:"blob_gas_used" yaml:"blob_gas_used,omitempty"`
+	BlobGasPrice         null.String       `boil:"blob_gas_price" json:"blob_gas_price,omitempty" toml:"blob_gas_price" yaml:"blob_gas_price,omitempty"`
+	Verified             bool              `boil:"verified" json:"verified" toml:"verified" yaml:"verified"`
 
 	R *transactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TransactionColumns = struct {
-	TXHash          string
-	TXStatus        string
-	BlockHash       string
-	BlockNumber     string
-	FromAddress     string
-	ToAddress       string
-	ContractAddress string
-	LogsCount       string
-	Input           string
-	Value           string
+	TXHash               string
+	TXStatus             string
+	BlockHash            string
+	BlockNumber          string
+	FromAddress          string
+	ToAddress            string
+	ContractAddress      string
+	LogsCount            string
+	Input                string
+	Value                string
+	Pending              string
+	ChainID              string
+	Nonce                string
+	TXType               string
+	GasLimit             string
+	GasPrice             string
+	MaxFeePerGas         string
+	MaxPriorityFeePerGas string
+	MaxFeePerBlobGas     string
+	AccessList           string
+	GasUsed              string
+	EffectiveGasPrice    string
+	BlobGasUsed          string
+	BlobGasPrice         string
+	Verified             string
 }{
-	TXHash:          "tx_hash",
-	TXStatus:        "tx_status",
-	BlockHash:       "block_hash",
-	BlockNumber:     "block_number",
-	FromAddress:     "from_address",
-	ToAddress:       "to_address",
-	ContractAddress: "contract_address",
-	LogsCount:       "logs_count",
-	Input:           "input",
-	Value:           "value",
+	TXHash:               "tx_hash",
+	TXStatus:             "tx_status",
+	BlockHash:            "block_hash",
+	BlockNumber:          "block_number",
+	FromAddress:          "from_address",
+	ToAddress:            "to_address",
+	ContractAddress:      "contract_address",
+	LogsCount:            "logs_count",
+	Input:                "input",
+	Value:                "value",
+	Pending:              "pending",
+	ChainID:              "chain_id",
+	Nonce:                "nonce",
+	TXType:               "tx_type",
+	GasLimit:             "gas_limit",
+	GasPrice:             "gas_price",
+	MaxFeePerGas:         "max_fee_per_gas",
+	MaxPriorityFeePerGas: "max_priority_fee_per_gas",
+	MaxFeePerBlobGas:     "max_fee_per_blob_gas",
+	AccessList:           "access_list",
+	GasUsed:              "gas_used",
+	EffectiveGasPrice:    "effective_gas_price",
+	BlobGasUsed:          "blob_gas_used",
+	BlobGasPrice:         "blob_gas_price",
+	Verified:             "verified",
 }
 
 var TransactionTableColumns = struct {
-	TXHash          string
-	TXStatus        string
-	BlockHash       string
-	BlockNumber     string
-	FromAddress     string
-	ToAddress       string
-	ContractAddress string
-	LogsCount       string
-	Input           string
-	Value           string
+	TXHash               string
+	TXStatus             string
+	BlockHash            string
+	BlockNumber          string
+	FromAddress          string
+	ToAddress            string
+	ContractAddress      string
+	LogsCount            string
+	Input                string
+	Value                string
+	Pending              string
+	ChainID              string
+	Nonce                string
+	TXType               string
+	GasLimit             string
+	GasPrice             string
+	MaxFeePerGas         string
+	MaxPriorityFeePerGas string
+	MaxFeePerBlobGas     string
+	AccessList           string
+	GasUsed              string
+	EffectiveGasPrice    string
+	BlobGasUsed          string
+	BlobGasPrice         string
+	Verified             string
 }{
-	TXHash:          "transactions.tx_hash",
-	TXStatus:        "transactions.tx_status",
-	BlockHash:       "transactions.block_hash",
-	BlockNumber:     "transactions.block_number",
-	FromAddress:     "transactions.from_address",
-	ToAddress:       "transactions.to_address",
-	ContractAddress: "transactions.contract_address",
-	LogsCount:       "transactions.logs_count",
-	Input:           "transactions.input",
-	Value:           "transactions.value",
+	TXHash:               "transactions.tx_hash",
+	TXStatus:             "transactions.tx_status",
+	BlockHash:            "transactions.block_hash",
+	BlockNumber:          "transactions.block_number",
+	FromAddress:          "transactions.from_address",
+	ToAddress:            "transactions.to_address",
+	ContractAddress:      "transactions.contract_address",
+	LogsCount:            "transactions.logs_count",
+	Input:                "transactions.input",
+	Value:                "transactions.value",
+	Pending:              "transactions.pending",
+	ChainID:              "transactions.chain_id",
+	Nonce:                "transactions.nonce",
+	TXType:               "transactions.tx_type",
+	GasLimit:             "transactions.gas_limit",
+	GasPrice:             "transactions.gas_price",
+	MaxFeePerGas:         "transactions.max_fee_per_gas",
+	MaxPriorityFeePerGas: "transactions.max_priority_fee_per_gas",
+	MaxFeePerBlobGas:     "transactions.max_fee_per_blob_gas",
+	AccessList:           "transactions.access_list",
+	GasUsed:              "transactions.gas_used",
+	EffectiveGasPrice:    "transactions.effective_gas_price",
+	BlobGasUsed:          "transactions.blob_gas_used",
+	BlobGasPrice:         "transactions.blob_gas_price",
+	Verified:             "transactions.verified",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -140,67 +200,63 @@ func (w whereHelperint) NIN(slice []int) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpertypes_Decimal struct{ field string }
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertypes_NullDecimal struct{ field string }
 
-func (w whereHelpertypes_Decimal) EQ(x types.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpertypes_NullDecimal) EQ(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertypes_Decimal) NEQ(x types.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpertypes_NullDecimal) NEQ(x types.NullDecimal) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertypes_Decimal) LT(x types.Decimal) qm.QueryMod {
+func (w whereHelpertypes_NullDecimal) LT(x types.NullDecimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertypes_Decimal) LTE(x types.Decimal) qm.QueryMod {
+func (w whereHelpertypes_NullDecimal) LTE(x types.NullDecimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertypes_Decimal) GT(x types.Decimal) qm.QueryMod {
+func (w whereHelpertypes_NullDecimal) GT(x types.NullDecimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertypes_Decimal) GTE(x types.Decimal) qm.QueryMod {
+func (w whereHelpertypes_NullDecimal) GTE(x types.NullDecimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpernull_String struct{ field string }
+func (w whereHelpertypes_NullDecimal) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_NullDecimal) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
+type whereHelpernull_Int64 struct{ field string }
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
-}
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -208,66 +264,93 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelperint64 struct{ field string }
+type whereHelpernull_JSON struct{ field string }
 
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var TransactionWhere = struct {
-	TXHash          whereHelperstring
-	TXStatus        whereHelperint
-	BlockHash       whereHelperstring
-	BlockNumber     whereHelpertypes_Decimal
-	FromAddress     whereHelperstring
-	ToAddress       whereHelpernull_String
-	ContractAddress whereHelpernull_String
-	LogsCount       whereHelperint64
-	Input           whereHelperstring
-	Value           whereHelperstring
+	TXHash               whereHelperstring
+	TXStatus             whereHelpernull_Int
+	BlockHash            whereHelpernull_String
+	BlockNumber          whereHelpertypes_NullDecimal
+	FromAddress          whereHelperstring
+	ToAddress            whereHelpernull_String
+	ContractAddress      whereHelpernull_String
+	LogsCount            whereHelperint64
+	Input                whereHelperstring
+	Value                whereHelperstring
+	Pending              whereHelperbool
+	ChainID              whereHelperint64
+	Nonce                whereHelpernull_Int64
+	TXType               whereHelpernull_Int
+	GasLimit             whereHelpernull_Int64
+	GasPrice             whereHelpernull_String
+	MaxFeePerGas         whereHelpernull_String
+	MaxPriorityFeePerGas whereHelpernull_String
+	MaxFeePerBlobGas     whereHelpernull_String
+	AccessList           whereHelpernull_JSON
+	GasUsed              whereHelpernull_Int64
+	EffectiveGasPrice    whereHelpernull_String
+	BlobGasUsed          whereHelpernull_Int64
+	BlobGasPrice         whereHelpernull_String
+	Verified             whereHelperbool
 }{
-	TXHash:          whereHelperstring{field: "\"transactions\".\"tx_hash\""},
-	TXStatus:        whereHelperint{field: "\"transactions\".\"tx_status\""},
-	BlockHash:       whereHelperstring{field: "\"transactions\".\"block_hash\""},
-	BlockNumber:     whereHelpertypes_Decimal{field: "\"transactions\".\"block_number\""},
-	FromAddress:     whereHelperstring{field: "\"transactions\".\"from_address\""},
-	ToAddress:       whereHelpernull_String{field: "\"transactions\".\"to_address\""},
-	ContractAddress: whereHelpernull_String{field: "\"transactions\".\"contract_address\""},
-	LogsCount:       whereHelperint64{field: "\"transactions\".\"logs_count\""},
-	Input:           whereHelperstring{field: "\"transactions\".\"input\""},
-	Value:           whereHelperstring{field: "\"transactions\".\"value\""},
+	TXHash:               whereHelperstring{field: "\"transactions\".\"tx_hash\""},
+	TXStatus:             whereHelpernull_Int{field: "\"transactions\".\"tx_status\""},
+	BlockHash:            whereHelpernull_String{field: "\"transactions\".\"block_hash\""},
+	BlockNumber:          whereHelpertypes_NullDecimal{field: "\"transactions\".\"block_number\""},
+	FromAddress:          whereHelperstring{field: "\"transactions\".\"from_address\""},
+	ToAddress:            whereHelpernull_String{field: "\"transactions\".\"to_address\""},
+	ContractAddress:      whereHelpernull_String{field: "\"transactions\".\"contract_address\""},
+	LogsCount:            whereHelperint64{field: "\"transactions\".\"logs_count\""},
+	Input:                whereHelperstring{field: "\"transactions\".\"input\""},
+	Value:                whereHelperstring{field: "\"transactions\".\"value\""},
+	Pending:              whereHelperbool{field: "\"transactions\".\"pending\""},
+	ChainID:              whereHelperint64{field: "\"transactions\".\"chain_id\""},
+	Nonce:                whereHelpernull_Int64{field: "\"transactions\".\"nonce\""},
+	TXType:               whereHelpernull_Int{field: "\"transactions\".\"tx_type\""},
+	GasLimit:             whereHelpernull_Int64{field: "\"transactions\".\"gas_limit\""},
+	GasPrice:             whereHelpernull_String{field: "\"transactions\".\"gas_price\""},
+	MaxFeePerGas:         whereHelpernull_String{field: "\"transactions\".\"max_fee_per_gas\""},
+	MaxPriorityFeePerGas: whereHelpernull_String{field: "\"transactions\".\"max_priority_fee_per_gas\""},
+	MaxFeePerBlobGas:     whereHelpernull_String{field: "\"transactions\".\"max_fee_per_blob_gas\""},
+	AccessList:           whereHelpernull_JSON{field: "\"transactions\".\"access_list\""},
+	GasUsed:              whereHelpernull_Int64{field: "\"transactions\".\"gas_used\""},
+	EffectiveGasPrice:    whereHelpernull_String{field: "\"transactions\".\"effective_gas_price\""},
+	BlobGasUsed:          whereHelpernull_Int64{field: "\"transactions\".\"blob_gas_used\""},
+	BlobGasPrice:         whereHelpernull_String{field: "\"transactions\".\"blob_gas_price\""},
+	Verified:             whereHelperbool{field: "\"transactions\".\"verified\""},
 }
 
 // TransactionRels is where relationship names are stored.
 var TransactionRels = struct {
-	Users string
-}{
-	Users: "Users",
-}
+}{}
 
 // transactionR is where relationships are stored.
 type transactionR struct {
-	Users UserSlice `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -275,21 +358,14 @@ func (*transactionR) NewStruct() *transactionR {
 	return &transactionR{}
 }
 
-func (r *transactionR) GetUsers() UserSlice {
-	if r == nil {
-		return nil
-	}
-	return r.Users
-}
-
 // transactionL is where Load methods for each relationship are stored.
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"tx_hash", "tx_status", "block_hash", "block_number", "from_address", "to_address", "contract_address", "logs_count", "input", "value"}
-	transactionColumnsWithoutDefault = []string{"tx_hash", "tx_status", "block_hash", "block_number", "from_address", "logs_count", "input", "value"}
-	transactionColumnsWithDefault    = []string{"to_address", "contract_address"}
-	transactionPrimaryKeyColumns     = []string{"tx_hash"}
+	transactionAllColumns            = []string{"tx_hash", "tx_status", "block_hash", "block_number", "from_address", "to_address", "contract_address", "logs_count", "input", "value", "pending", "chain_id", "nonce", "tx_type", "gas_limit", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "max_fee_per_blob_gas", "access_list", "gas_used", "effective_gas_price", "blob_gas_used", "blob_gas_price", "verified"}
+	transactionColumnsWithoutDefault = []string{"tx_hash", "from_address", "logs_count", "input", "value", "chain_id"}
+	transactionColumnsWithDefault    = []string{"tx_status", "block_hash", "block_number", "to_address", "contract_address", "pending", "nonce", "tx_type", "gas_limit", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "max_fee_per_blob_gas", "access_list", "gas_used", "effective_gas_price", "blob_gas_used", "blob_gas_price", "verified"}
+	transactionPrimaryKeyColumns     = []string{"chain_id", "tx_hash"}
 	transactionGeneratedColumns      = []string{}
 )
 
@@ -598,296 +674,6 @@ func (q transactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
-// Users retrieves all the user's Users with an executor.
-func (o *Transaction) Users(mods ...qm.QueryMod) userQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
-	}
-
-	queryMods = append(queryMods,
-		qm.InnerJoin("\"user_transactions\" on \"users\".\"id\" = \"user_transactions\".\"user_id\""),
-		qm.Where("\"user_transactions\".\"tx_hash\"=?", o.TXHash),
-	)
-
-	return Users(queryMods...)
-}
-
-// LoadUsers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (transactionL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
-	var slice []*Transaction
-	var object *Transaction
-
-	if singular {
-		var ok bool
-		object, ok = maybeTransaction.(*Transaction)
-		if !ok {
-			object = new(Transaction)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
-			}
-		}
-	} else {
-		s, ok := maybeTransaction.(*[]*Transaction)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
-			}
-		}
-	}
-
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &transactionR{}
-		}
-		args[object.TXHash] = struct{}{}
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &transactionR{}
-			}
-			args[obj.TXHash] = struct{}{}
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
-
-	query := NewQuery(
-		qm.Select("\"users\".\"id\", \"users\".\"username\", \"users\".\"password\", \"a\".\"tx_hash\""),
-		qm.From("\"users\""),
-		qm.InnerJoin("\"user_transactions\" as \"a\" on \"users\".\"id\" = \"a\".\"user_id\""),
-		qm.WhereIn("\"a\".\"tx_hash\" in ?", argsSlice...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
-	}
-
-	var resultSlice []*User
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(User)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.Username, &one.Password, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for users")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
-	}
-
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
-	}
-	if singular {
-		object.R.Users = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &userR{}
-			}
-			foreign.R.TXHashTransactions = append(foreign.R.TXHashTransactions, object)
-		}
-		return nil
-	}
-
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.TXHash == localJoinCol {
-				local.R.Users = append(local.R.Users, foreign)
-				if foreign.R == nil {
-					foreign.R = &userR{}
-				}
-				foreign.R.TXHashTransactions = append(foreign.R.TXHashTransactions, local)
-				break
-			}
-		}
-	}
-
-	return nil
-}
-
-// AddUsers adds the given related objects to the existing relationships
-// of the transaction, optionally inserting them as new records.
-// Appends related to o.R.Users.
-// Sets related.R.TXHashTransactions appropriately.
-func (o *Transaction) AddUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		}
-	}
-
-	for _, rel := range related {
-		query := "insert into \"user_transactions\" (\"tx_hash\", \"user_id\") values ($1, $2)"
-		values := []interface{}{o.TXHash, rel.ID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
-		}
-	}
-	if o.R == nil {
-		o.R = &transactionR{
-			Users: related,
-		}
-	} else {
-		o.R.Users = append(o.R.Users, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &userR{
-				TXHashTransactions: TransactionSlice{o},
-			}
-		} else {
-			rel.R.TXHashTransactions = append(rel.R.TXHashTransactions, o)
-		}
-	}
-	return nil
-}
-
-// SetUsers removes all previously related items of the
-// transaction replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.TXHashTransactions's Users accordingly.
-// Replaces o.R.Users with related.
-// Sets related.R.TXHashTransactions's Users accordingly.
-func (o *Transaction) SetUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
-	query := "delete from \"user_transactions\" where \"tx_hash\" = $1"
-	values := []interface{}{o.TXHash}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeUsersFromTXHashTransactionsSlice(o, related)
-	if o.R != nil {
-		o.R.Users = nil
-	}
-
-	return o.AddUsers(ctx, exec, insert, related...)
-}
-
-// RemoveUsers relationships from objects passed in.
-// Removes related items from R.Users (uses pointer comparison, removal does not keep order)
-// Sets related.R.TXHashTransactions.
-func (o *Transaction) RemoveUsers(ctx context.Context, exec boil.ContextExecutor, related ...*User) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	query := fmt.Sprintf(
-		"delete from \"user_transactions\" where \"tx_hash\" = $1 and \"user_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.TXHash}
-	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeUsersFromTXHashTransactionsSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.Users {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.Users)
-			if ln > 1 && i < ln-1 {
-				o.R.Users[i] = o.R.Users[ln-1]
-			}
-			o.R.Users = o.R.Users[:ln-1]
-			break
-		}
-	}
-
-	return nil
-}
-
-func removeUsersFromTXHashTransactionsSlice(o *Transaction, related []*User) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.TXHashTransactions {
-			if o.TXHash != ri.TXHash {
-				continue
-			}
-
-			ln := len(rel.R.TXHashTransactions)
-			if ln > 1 && i < ln-1 {
-				rel.R.TXHashTransactions[i] = rel.R.TXHashTransactions[ln-1]
-			}
-			rel.R.TXHashTransactions = rel.R.TXHashTransactions[:ln-1]
-			break
-		}
-	}
-}
-
 // Transactions retrieves all the records using an executor.
 func Transactions(mods ...qm.QueryMod) transactionQuery {
 	mods = append(mods, qm.From("\"transactions\""))
@@ -901,7 +687,7 @@ func Transactions(mods ...qm.QueryMod) transactionQuery {
 
 // FindTransaction retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindTransaction(ctx context.Context, exec boil.ContextExecutor, tXHash string, selectCols ...string) (*Transaction, error) {
+func FindTransaction(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string, selectCols ...string) (*Transaction, error) {
 	transactionObj := &Transaction{}
 
 	sel := "*"
@@ -909,10 +695,10 @@ func FindTransaction(ctx context.Context, exec boil.ContextExecutor, tXHash stri
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"transactions\" where \"tx_hash\"=$1", sel,
+		"select %s from \"transactions\" where \"chain_id\"=$1 AND \"tx_hash\"=$2", sel,
 	)
 
-	q := queries.Raw(query, tXHash)
+	q := queries.Raw(query, chainID, tXHash)
 
 	err := q.Bind(ctx, exec, transactionObj)
 	if err != nil {
@@ -1270,7 +1056,7 @@ func (o *Transaction) Delete(ctx context.Context, exec boil.ContextExecutor) (in
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transactionPrimaryKeyMapping)
-	sql := "DELETE FROM \"transactions\" WHERE \"tx_hash\"=$1"
+	sql := "DELETE FROM \"transactions\" WHERE \"chain_id\"=$1 AND \"tx_hash\"=$2"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1367,7 +1153,7 @@ func (o TransactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecut
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *Transaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindTransaction(ctx, exec, o.TXHash)
+	ret, err := FindTransaction(ctx, exec, o.ChainID, o.TXHash)
 	if err != nil {
 		return err
 	}
@@ -1406,16 +1192,16 @@ func (o *TransactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecu
 }
 
 // TransactionExists checks if the Transaction row exists.
-func TransactionExists(ctx context.Context, exec boil.ContextExecutor, tXHash string) (bool, error) {
+func TransactionExists(ctx context.Context, exec boil.ContextExecutor, chainID int64, tXHash string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"transactions\" where \"tx_hash\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"transactions\" where \"chain_id\"=$1 AND \"tx_hash\"=$2 limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, tXHash)
+		fmt.Fprintln(writer, chainID, tXHash)
 	}
-	row := exec.QueryRowContext(ctx, sql, tXHash)
+	row := exec.QueryRowContext(ctx, sql, chainID, tXHash)
 
 	err := row.Scan(&exists)
 	if err != nil {
@@ -1427,5 +1213,5 @@ func TransactionExists(ctx context.Context, exec boil.ContextExecutor, tXHash st
 
 // Exists checks if the Transaction row exists.
 func (o *Transaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	return TransactionExists(ctx, exec, o.TXHash)
+	return TransactionExists(ctx, exec, o.ChainID, o.TXHash)
 }
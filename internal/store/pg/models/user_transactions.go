@@ -0,0 +1,1124 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserTransaction is an object representing the database table.
+type UserTransaction struct {
+	UserID  int    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TXHash  string `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	ChainID int64  `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+
+	R *userTransactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userTransactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserTransactionColumns = struct {
+	UserID  string
+	TXHash  string
+	ChainID string
+}{
+	UserID:  "user_id",
+	TXHash:  "tx_hash",
+	ChainID: "chain_id",
+}
+
+var UserTransactionTableColumns = struct {
+	UserID  string
+	TXHash  string
+	ChainID string
+}{
+	UserID:  "user_transactions.user_id",
+	TXHash:  "user_transactions.tx_hash",
+	ChainID: "user_transactions.chain_id",
+}
+
+// Generated where
+
+var UserTransactionWhere = struct {
+	UserID  whereHelperint
+	TXHash  whereHelperstring
+	ChainID whereHelperint64
+}{
+	UserID:  whereHelperint{field: "\"user_transactions\".\"user_id\""},
+	TXHash:  whereHelperstring{field: "\"user_transactions\".\"tx_hash\""},
+	ChainID: whereHelperint64{field: "\"user_transactions\".\"chain_id\""},
+}
+
+// UserTransactionRels is where relationship names are stored.
+var UserTransactionRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// userTransactionR is where relationships are stored.
+type userTransactionR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userTransactionR) NewStruct() *userTransactionR {
+	return &userTransactionR{}
+}
+
+func (r *userTransactionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// userTransactionL is where Load methods for each relationship are stored.
+type userTransactionL struct{}
+
+var (
+	userTransactionAllColumns            = []string{"user_id", "tx_hash", "chain_id"}
+	userTransactionColumnsWithoutDefault = []string{"user_id", "tx_hash", "chain_id"}
+	userTransactionColumnsWithDefault    = []string{}
+	userTransactionPrimaryKeyColumns     = []string{"user_id", "chain_id", "tx_hash"}
+	userTransactionGeneratedColumns      = []string{}
+)
+
+type (
+	// UserTransactionSlice is an alias for a slice of pointers to UserTransaction.
+	// This should almost always be used instead of []UserTransaction.
+	UserTransactionSlice []*UserTransaction
+	// UserTransactionHook is the signature for custom UserTransaction hook methods
+	UserTransactionHook func(context.Context, boil.ContextExecutor, *UserTransaction) error
+
+	userTransactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userTransactionType                 = reflect.TypeOf(&UserTransaction{})
+	userTransactionMapping              = queries.MakeStructMapping(userTransactionType)
+	userTransactionPrimaryKeyMapping, _ = queries.BindMapping(userTransactionType, userTransactionMapping, userTransactionPrimaryKeyColumns)
+	userTransactionInsertCacheMut       sync.RWMutex
+	userTransactionInsertCache          = make(map[string]insertCache)
+	userTransactionUpdateCacheMut       sync.RWMutex
+	userTransactionUpdateCache          = make(map[string]updateCache)
+	userTransactionUpsertCacheMut       sync.RWMutex
+	userTransactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userTransactionAfterSelectMu sync.Mutex
+var userTransactionAfterSelectHooks []UserTransactionHook
+
+var userTransactionBeforeInsertMu sync.Mutex
+var userTransactionBeforeInsertHooks []UserTransactionHook
+var userTransactionAfterInsertMu sync.Mutex
+var userTransactionAfterInsertHooks []UserTransactionHook
+
+var userTransactionBeforeUpdateMu sync.Mutex
+var userTransactionBeforeUpdateHooks []UserTransactionHook
+var userTransactionAfterUpdateMu sync.Mutex
+var userTransactionAfterUpdateHooks []UserTransactionHook
+
+var userTransactionBeforeDeleteMu sync.Mutex
+var userTransactionBeforeDeleteHooks []UserTransactionHook
+var userTransactionAfterDeleteMu sync.Mutex
+var userTransactionAfterDeleteHooks []UserTransactionHook
+
+var userTransactionBeforeUpsertMu sync.Mutex
+var userTransactionBeforeUpsertHooks []UserTransactionHook
+var userTransactionAfterUpsertMu sync.Mutex
+var userTransactionAfterUpsertHooks []UserTransactionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserTransaction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserTransaction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserTransaction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserTransaction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserTransaction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserTransaction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserTransaction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserTransaction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserTransaction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTransactionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserTransactionHook registers your hook function for all future operations.
+func AddUserTransactionHook(hookPoint boil.HookPoint, userTransactionHook UserTransactionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userTransactionAfterSelectMu.Lock()
+		userTransactionAfterSelectHooks = append(userTransactionAfterSelectHooks, userTransactionHook)
+		userTransactionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userTransactionBeforeInsertMu.Lock()
+		userTransactionBeforeInsertHooks = append(userTransactionBeforeInsertHooks, userTransactionHook)
+		userTransactionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userTransactionAfterInsertMu.Lock()
+		userTransactionAfterInsertHooks = append(userTransactionAfterInsertHooks, userTransactionHook)
+		userTransactionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userTransactionBeforeUpdateMu.Lock()
+		userTransactionBeforeUpdateHooks = append(userTransactionBeforeUpdateHooks, userTransactionHook)
+		userTransactionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userTransactionAfterUpdateMu.Lock()
+		userTransactionAfterUpdateHooks = append(userTransactionAfterUpdateHooks, userTransactionHook)
+		userTransactionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userTransactionBeforeDeleteMu.Lock()
+		userTransactionBeforeDeleteHooks = append(userTransactionBeforeDeleteHooks, userTransactionHook)
+		userTransactionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userTransactionAfterDeleteMu.Lock()
+		userTransactionAfterDeleteHooks = append(userTransactionAfterDeleteHooks, userTransactionHook)
+		userTransactionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userTransactionBeforeUpsertMu.Lock()
+		userTransactionBeforeUpsertHooks = append(userTransactionBeforeUpsertHooks, userTransactionHook)
+		userTransactionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userTransactionAfterUpsertMu.Lock()
+		userTransactionAfterUpsertHooks = append(userTransactionAfterUpsertHooks, userTransactionHook)
+		userTransactionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userTransaction record from the query.
+func (q userTransactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserTransaction, error) {
+	o := &UserTransaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_transactions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserTransaction records from the query.
+func (q userTransactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserTransactionSlice, error) {
+	var o []*UserTransaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserTransaction slice")
+	}
+
+	if len(userTransactionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserTransaction records in the query.
+func (q userTransactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_transactions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userTransactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_transactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *UserTransaction) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userTransactionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserTransaction interface{}, mods queries.Applicator) error {
+	var slice []*UserTransaction
+	var object *UserTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserTransaction.(*UserTransaction)
+		if !ok {
+			object = new(UserTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeUserTransaction.(*[]*UserTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userTransactionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userTransactionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserTransactions = append(foreign.R.UserTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserTransactions = append(foreign.R.UserTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the userTransaction to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTransactions.
+func (o *UserTransaction) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.ChainID, o.TXHash}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userTransactionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserTransactions: UserTransactionSlice{o},
+		}
+	} else {
+		related.R.UserTransactions = append(related.R.UserTransactions, o)
+	}
+
+	return nil
+}
+
+// UserTransactions retrieves all the records using an executor.
+func UserTransactions(mods ...qm.QueryMod) userTransactionQuery {
+	mods = append(mods, qm.From("\"user_transactions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_transactions\".*"})
+	}
+
+	return userTransactionQuery{q}
+}
+
+// FindUserTransaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserTransaction(ctx context.Context, exec boil.ContextExecutor, userID int, chainID int64, tXHash string, selectCols ...string) (*UserTransaction, error) {
+	userTransactionObj := &UserTransaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_transactions\" where \"user_id\"=$1 AND \"chain_id\"=$2 AND \"tx_hash\"=$3", sel,
+	)
+
+	q := queries.Raw(query, userID, chainID, tXHash)
+
+	err := q.Bind(ctx, exec, userTransactionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_transactions")
+	}
+
+	if err = userTransactionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userTransactionObj, err
+	}
+
+	return userTransactionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserTransaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_transactions provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTransactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userTransactionInsertCacheMut.RLock()
+	cache, cached := userTransactionInsertCache[key]
+	userTransactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userTransactionAllColumns,
+			userTransactionColumnsWithDefault,
+			userTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userTransactionType, userTransactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userTransactionType, userTransactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_transactions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_transactions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_transactions")
+	}
+
+	if !cached {
+		userTransactionInsertCacheMut.Lock()
+		userTransactionInsertCache[key] = cache
+		userTransactionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserTransaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserTransaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userTransactionUpdateCacheMut.RLock()
+	cache, cached := userTransactionUpdateCache[key]
+	userTransactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userTransactionAllColumns,
+			userTransactionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_transactions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_transactions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userTransactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userTransactionType, userTransactionMapping, append(wl, userTransactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_transactions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_transactions")
+	}
+
+	if !cached {
+		userTransactionUpdateCacheMut.Lock()
+		userTransactionUpdateCache[key] = cache
+		userTransactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userTransactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserTransactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userTransactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userTransaction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserTransaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no user_transactions provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTransactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userTransactionUpsertCacheMut.RLock()
+	cache, cached := userTransactionUpsertCache[key]
+	userTransactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userTransactionAllColumns,
+			userTransactionColumnsWithDefault,
+			userTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userTransactionAllColumns,
+			userTransactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert user_transactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userTransactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userTransactionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert user_transactions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userTransactionPrimaryKeyColumns))
+			copy(conflict, userTransactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_transactions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userTransactionType, userTransactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userTransactionType, userTransactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert user_transactions")
+	}
+
+	if !cached {
+		userTransactionUpsertCacheMut.Lock()
+		userTransactionUpsertCache[key] = cache
+		userTransactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserTransaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserTransaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserTransaction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userTransactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_transactions\" WHERE \"user_id\"=$1 AND \"chain_id\"=$2 AND \"tx_hash\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_transactions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userTransactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userTransactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserTransactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userTransactionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTransactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_transactions")
+	}
+
+	if len(userTransactionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserTransaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserTransaction(ctx, exec, o.UserID, o.ChainID, o.TXHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserTransactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserTransactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_transactions\".* FROM \"user_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTransactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserTransactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserTransactionExists checks if the UserTransaction row exists.
+func UserTransactionExists(ctx context.Context, exec boil.ContextExecutor, userID int, chainID int64, tXHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_transactions\" where \"user_id\"=$1 AND \"chain_id\"=$2 AND \"tx_hash\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, chainID, tXHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, chainID, tXHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_transactions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserTransaction row exists.
+func (o *UserTransaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserTransactionExists(ctx, exec, o.UserID, o.ChainID, o.TXHash)
+}
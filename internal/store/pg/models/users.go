@@ -65,14 +65,17 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	TXHashTransactions string
+	UserTransactions string
+	WatchedAddresses string
 }{
-	TXHashTransactions: "TXHashTransactions",
+	UserTransactions: "UserTransactions",
+	WatchedAddresses: "WatchedAddresses",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	TXHashTransactions TransactionSlice `boil:"TXHashTransactions" json:"TXHashTransactions" toml:"TXHashTransactions" yaml:"TXHashTransactions"`
+	UserTransactions UserTransactionSlice `boil:"UserTransactions" json:"UserTransactions" toml:"UserTransactions" yaml:"UserTransactions"`
+	WatchedAddresses WatchedAddressSlice  `boil:"WatchedAddresses" json:"WatchedAddresses" toml:"WatchedAddresses" yaml:"WatchedAddresses"`
 }
 
 // NewStruct creates a new relationship struct
@@ -80,11 +83,18 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
-func (r *userR) GetTXHashTransactions() TransactionSlice {
+func (r *userR) GetUserTransactions() UserTransactionSlice {
 	if r == nil {
 		return nil
 	}
-	return r.TXHashTransactions
+	return r.UserTransactions
+}
+
+func (r *userR) GetWatchedAddresses() WatchedAddressSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WatchedAddresses
 }
 
 // userL is where Load methods for each relationship are stored.
@@ -403,24 +413,37 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
-// TXHashTransactions retrieves all the transaction's Transactions with an executor via tx_hash column.
-func (o *User) TXHashTransactions(mods ...qm.QueryMod) transactionQuery {
+// UserTransactions retrieves all the user_transaction's UserTransactions with an executor.
+func (o *User) UserTransactions(mods ...qm.QueryMod) userTransactionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("\"user_transactions\" on \"transactions\".\"tx_hash\" = \"user_transactions\".\"tx_hash\""),
 		qm.Where("\"user_transactions\".\"user_id\"=?", o.ID),
 	)
 
-	return Transactions(queryMods...)
+	return UserTransactions(queryMods...)
 }
 
-// LoadTXHashTransactions allows an eager lookup of values, cached into the
+// WatchedAddresses retrieves all the watched_address's WatchedAddresses with an executor.
+func (o *User) WatchedAddresses(mods ...qm.QueryMod) watchedAddressQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watched_addresses\".\"user_id\"=?", o.ID),
+	)
+
+	return WatchedAddresses(queryMods...)
+}
+
+// LoadUserTransactions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTXHashTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -473,10 +496,8 @@ func (userL) LoadTXHashTransactions(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.Select("\"transactions\".\"tx_hash\", \"transactions\".\"tx_status\", \"transactions\".\"block_hash\", \"transactions\".\"block_number\", \"transactions\".\"from_address\", \"transactions\".\"to_address\", \"transactions\".\"contract_address\", \"transactions\".\"logs_count\", \"transactions\".\"input\", \"transactions\".\"value\", \"a\".\"user_id\""),
-		qm.From("\"transactions\""),
-		qm.InnerJoin("\"user_transactions\" as \"a\" on \"transactions\".\"tx_hash\" = \"a\".\"tx_hash\""),
-		qm.WhereIn("\"a\".\"user_id\" in ?", argsSlice...),
+		qm.From(`user_transactions`),
+		qm.WhereIn(`user_transactions.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -484,36 +505,135 @@ func (userL) LoadTXHashTransactions(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions")
+		return errors.Wrap(err, "failed to eager load user_transactions")
 	}
 
-	var resultSlice []*Transaction
+	var resultSlice []*UserTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_transactions")
+	}
 
-	var localJoinCols []int
-	for results.Next() {
-		one := new(Transaction)
-		var localJoinCol int
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_transactions")
+	}
 
-		err = results.Scan(&one.TXHash, &one.TXStatus, &one.BlockHash, &one.BlockNumber, &one.FromAddress, &one.ToAddress, &one.ContractAddress, &one.LogsCount, &one.Input, &one.Value, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for transactions")
+	if len(userTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userTransactionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTransactions = append(local.R.UserTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userTransactionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchedAddresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWatchedAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice transactions")
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
 
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`watched_addresses`),
+		qm.WhereIn(`watched_addresses.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watched_addresses")
+	}
+
+	var resultSlice []*WatchedAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watched_addresses")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions")
+		return errors.Wrap(err, "failed to close results in eager load on watched_addresses")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watched_addresses")
 	}
 
-	if len(transactionAfterSelectHooks) != 0 {
+	if len(watchedAddressAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -521,25 +641,24 @@ func (userL) LoadTXHashTransactions(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.TXHashTransactions = resultSlice
+		object.R.WatchedAddresses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionR{}
+				foreign.R = &watchedAddressR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.TXHashTransactions = append(local.R.TXHashTransactions, foreign)
+			if local.ID == foreign.UserID {
+				local.R.WatchedAddresses = append(local.R.WatchedAddresses, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionR{}
+					foreign.R = &watchedAddressR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -548,149 +667,110 @@ func (userL) LoadTXHashTransactions(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// AddTXHashTransactions adds the given related objects to the existing relationships
+// AddUserTransactions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.TXHashTransactions.
-// Sets related.R.Users appropriately.
-func (o *User) AddTXHashTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+// Appends related to o.R.UserTransactions.
+// Sets related.R.User appropriately.
+func (o *User) AddUserTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserTransaction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
-
-	for _, rel := range related {
-		query := "insert into \"user_transactions\" (\"user_id\", \"tx_hash\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.TXHash}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.ChainID, rel.TXHash}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
 		o.R = &userR{
-			TXHashTransactions: related,
+			UserTransactions: related,
 		}
 	} else {
-		o.R.TXHashTransactions = append(o.R.TXHashTransactions, related...)
+		o.R.UserTransactions = append(o.R.UserTransactions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &transactionR{
-				Users: UserSlice{o},
+			rel.R = &userTransactionR{
+				User: o,
 			}
 		} else {
-			rel.R.Users = append(rel.R.Users, o)
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetTXHashTransactions removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Users's TXHashTransactions accordingly.
-// Replaces o.R.TXHashTransactions with related.
-// Sets related.R.Users's TXHashTransactions accordingly.
-func (o *User) SetTXHashTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
-	query := "delete from \"user_transactions\" where \"user_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeTXHashTransactionsFromUsersSlice(o, related)
-	if o.R != nil {
-		o.R.TXHashTransactions = nil
-	}
-
-	return o.AddTXHashTransactions(ctx, exec, insert, related...)
-}
-
-// RemoveTXHashTransactions relationships from objects passed in.
-// Removes related items from R.TXHashTransactions (uses pointer comparison, removal does not keep order)
-// Sets related.R.Users.
-func (o *User) RemoveTXHashTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
-	if len(related) == 0 {
-		return nil
-	}
-
+// AddWatchedAddresses adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WatchedAddresses.
+// Sets related.R.User appropriately.
+func (o *User) AddWatchedAddresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WatchedAddress) error {
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"user_transactions\" where \"user_id\" = $1 and \"tx_hash\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.TXHash)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeTXHashTransactionsFromUsersSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.TXHashTransactions {
-			if rel != ri {
-				continue
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-
-			ln := len(o.R.TXHashTransactions)
-			if ln > 1 && i < ln-1 {
-				o.R.TXHashTransactions[i] = o.R.TXHashTransactions[ln-1]
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watched_addresses\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, watchedAddressPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.ChainID, rel.Address}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
-			o.R.TXHashTransactions = o.R.TXHashTransactions[:ln-1]
-			break
+
+			rel.UserID = o.ID
 		}
 	}
 
-	return nil
-}
+	if o.R == nil {
+		o.R = &userR{
+			WatchedAddresses: related,
+		}
+	} else {
+		o.R.WatchedAddresses = append(o.R.WatchedAddresses, related...)
+	}
 
-func removeTXHashTransactionsFromUsersSlice(o *User, related []*Transaction) {
 	for _, rel := range related {
 		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.Users {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.Users)
-			if ln > 1 && i < ln-1 {
-				rel.R.Users[i] = rel.R.Users[ln-1]
+			rel.R = &watchedAddressR{
+				User: o,
 			}
-			rel.R.Users = rel.R.Users[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
+	return nil
 }
 
 // Users retrieves all the records using an executor.
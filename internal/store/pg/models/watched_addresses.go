@@ -0,0 +1,1124 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WatchedAddress is an object representing the database table.
+type WatchedAddress struct {
+	UserID  int    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ChainID int64  `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	Address string `boil:"address" json:"address" toml:"address" yaml:"address"`
+
+	R *watchedAddressR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L watchedAddressL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WatchedAddressColumns = struct {
+	UserID  string
+	ChainID string
+	Address string
+}{
+	UserID:  "user_id",
+	ChainID: "chain_id",
+	Address: "address",
+}
+
+var WatchedAddressTableColumns = struct {
+	UserID  string
+	ChainID string
+	Address string
+}{
+	UserID:  "watched_addresses.user_id",
+	ChainID: "watched_addresses.chain_id",
+	Address: "watched_addresses.address",
+}
+
+// Generated where
+
+var WatchedAddressWhere = struct {
+	UserID  whereHelperint
+	ChainID whereHelperint64
+	Address whereHelperstring
+}{
+	UserID:  whereHelperint{field: "\"watched_addresses\".\"user_id\""},
+	ChainID: whereHelperint64{field: "\"watched_addresses\".\"chain_id\""},
+	Address: whereHelperstring{field: "\"watched_addresses\".\"address\""},
+}
+
+// WatchedAddressRels is where relationship names are stored.
+var WatchedAddressRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// watchedAddressR is where relationships are stored.
+type watchedAddressR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*watchedAddressR) NewStruct() *watchedAddressR {
+	return &watchedAddressR{}
+}
+
+func (r *watchedAddressR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// watchedAddressL is where Load methods for each relationship are stored.
+type watchedAddressL struct{}
+
+var (
+	watchedAddressAllColumns            = []string{"user_id", "chain_id", "address"}
+	watchedAddressColumnsWithoutDefault = []string{"user_id", "chain_id", "address"}
+	watchedAddressColumnsWithDefault    = []string{}
+	watchedAddressPrimaryKeyColumns     = []string{"user_id", "chain_id", "address"}
+	watchedAddressGeneratedColumns      = []string{}
+)
+
+type (
+	// WatchedAddressSlice is an alias for a slice of pointers to WatchedAddress.
+	// This should almost always be used instead of []WatchedAddress.
+	WatchedAddressSlice []*WatchedAddress
+	// WatchedAddressHook is the signature for custom WatchedAddress hook methods
+	WatchedAddressHook func(context.Context, boil.ContextExecutor, *WatchedAddress) error
+
+	watchedAddressQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	watchedAddressType                 = reflect.TypeOf(&WatchedAddress{})
+	watchedAddressMapping              = queries.MakeStructMapping(watchedAddressType)
+	watchedAddressPrimaryKeyMapping, _ = queries.BindMapping(watchedAddressType, watchedAddressMapping, watchedAddressPrimaryKeyColumns)
+	watchedAddressInsertCacheMut       sync.RWMutex
+	watchedAddressInsertCache          = make(map[string]insertCache)
+	watchedAddressUpdateCacheMut       sync.RWMutex
+	watchedAddressUpdateCache          = make(map[string]updateCache)
+	watchedAddressUpsertCacheMut       sync.RWMutex
+	watchedAddressUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var watchedAddressAfterSelectMu sync.Mutex
+var watchedAddressAfterSelectHooks []WatchedAddressHook
+
+var watchedAddressBeforeInsertMu sync.Mutex
+var watchedAddressBeforeInsertHooks []WatchedAddressHook
+var watchedAddressAfterInsertMu sync.Mutex
+var watchedAddressAfterInsertHooks []WatchedAddressHook
+
+var watchedAddressBeforeUpdateMu sync.Mutex
+var watchedAddressBeforeUpdateHooks []WatchedAddressHook
+var watchedAddressAfterUpdateMu sync.Mutex
+var watchedAddressAfterUpdateHooks []WatchedAddressHook
+
+var watchedAddressBeforeDeleteMu sync.Mutex
+var watchedAddressBeforeDeleteHooks []WatchedAddressHook
+var watchedAddressAfterDeleteMu sync.Mutex
+var watchedAddressAfterDeleteHooks []WatchedAddressHook
+
+var watchedAddressBeforeUpsertMu sync.Mutex
+var watchedAddressBeforeUpsertHooks []WatchedAddressHook
+var watchedAddressAfterUpsertMu sync.Mutex
+var watchedAddressAfterUpsertHooks []WatchedAddressHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WatchedAddress) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WatchedAddress) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WatchedAddress) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WatchedAddress) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WatchedAddress) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WatchedAddress) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WatchedAddress) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WatchedAddress) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WatchedAddress) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchedAddressAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWatchedAddressHook registers your hook function for all future operations.
+func AddWatchedAddressHook(hookPoint boil.HookPoint, watchedAddressHook WatchedAddressHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		watchedAddressAfterSelectMu.Lock()
+		watchedAddressAfterSelectHooks = append(watchedAddressAfterSelectHooks, watchedAddressHook)
+		watchedAddressAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		watchedAddressBeforeInsertMu.Lock()
+		watchedAddressBeforeInsertHooks = append(watchedAddressBeforeInsertHooks, watchedAddressHook)
+		watchedAddressBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		watchedAddressAfterInsertMu.Lock()
+		watchedAddressAfterInsertHooks = append(watchedAddressAfterInsertHooks, watchedAddressHook)
+		watchedAddressAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		watchedAddressBeforeUpdateMu.Lock()
+		watchedAddressBeforeUpdateHooks = append(watchedAddressBeforeUpdateHooks, watchedAddressHook)
+		watchedAddressBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		watchedAddressAfterUpdateMu.Lock()
+		watchedAddressAfterUpdateHooks = append(watchedAddressAfterUpdateHooks, watchedAddressHook)
+		watchedAddressAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		watchedAddressBeforeDeleteMu.Lock()
+		watchedAddressBeforeDeleteHooks = append(watchedAddressBeforeDeleteHooks, watchedAddressHook)
+		watchedAddressBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		watchedAddressAfterDeleteMu.Lock()
+		watchedAddressAfterDeleteHooks = append(watchedAddressAfterDeleteHooks, watchedAddressHook)
+		watchedAddressAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		watchedAddressBeforeUpsertMu.Lock()
+		watchedAddressBeforeUpsertHooks = append(watchedAddressBeforeUpsertHooks, watchedAddressHook)
+		watchedAddressBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		watchedAddressAfterUpsertMu.Lock()
+		watchedAddressAfterUpsertHooks = append(watchedAddressAfterUpsertHooks, watchedAddressHook)
+		watchedAddressAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single watchedAddress record from the query.
+func (q watchedAddressQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WatchedAddress, error) {
+	o := &WatchedAddress{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for watched_addresses")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WatchedAddress records from the query.
+func (q watchedAddressQuery) All(ctx context.Context, exec boil.ContextExecutor) (WatchedAddressSlice, error) {
+	var o []*WatchedAddress
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WatchedAddress slice")
+	}
+
+	if len(watchedAddressAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WatchedAddress records in the query.
+func (q watchedAddressQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count watched_addresses rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q watchedAddressQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if watched_addresses exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WatchedAddress) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (watchedAddressL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchedAddress interface{}, mods queries.Applicator) error {
+	var slice []*WatchedAddress
+	var object *WatchedAddress
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchedAddress.(*WatchedAddress)
+		if !ok {
+			object = new(WatchedAddress)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchedAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchedAddress))
+			}
+		}
+	} else {
+		s, ok := maybeWatchedAddress.(*[]*WatchedAddress)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchedAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchedAddress))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &watchedAddressR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchedAddressR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WatchedAddresses = append(foreign.R.WatchedAddresses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WatchedAddresses = append(foreign.R.WatchedAddresses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the watchedAddress to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WatchedAddresses.
+func (o *WatchedAddress) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"watched_addresses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, watchedAddressPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.ChainID, o.Address}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &watchedAddressR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WatchedAddresses: WatchedAddressSlice{o},
+		}
+	} else {
+		related.R.WatchedAddresses = append(related.R.WatchedAddresses, o)
+	}
+
+	return nil
+}
+
+// WatchedAddresses retrieves all the records using an executor.
+func WatchedAddresses(mods ...qm.QueryMod) watchedAddressQuery {
+	mods = append(mods, qm.From("\"watched_addresses\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"watched_addresses\".*"})
+	}
+
+	return watchedAddressQuery{q}
+}
+
+// FindWatchedAddress retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWatchedAddress(ctx context.Context, exec boil.ContextExecutor, userID int, chainID int64, address string, selectCols ...string) (*WatchedAddress, error) {
+	watchedAddressObj := &WatchedAddress{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"watched_addresses\" where \"user_id\"=$1 AND \"chain_id\"=$2 AND \"address\"=$3", sel,
+	)
+
+	q := queries.Raw(query, userID, chainID, address)
+
+	err := q.Bind(ctx, exec, watchedAddressObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from watched_addresses")
+	}
+
+	if err = watchedAddressObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return watchedAddressObj, err
+	}
+
+	return watchedAddressObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WatchedAddress) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watched_addresses provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchedAddressColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	watchedAddressInsertCacheMut.RLock()
+	cache, cached := watchedAddressInsertCache[key]
+	watchedAddressInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			watchedAddressAllColumns,
+			watchedAddressColumnsWithDefault,
+			watchedAddressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(watchedAddressType, watchedAddressMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(watchedAddressType, watchedAddressMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"watched_addresses\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"watched_addresses\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into watched_addresses")
+	}
+
+	if !cached {
+		watchedAddressInsertCacheMut.Lock()
+		watchedAddressInsertCache[key] = cache
+		watchedAddressInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WatchedAddress.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WatchedAddress) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	watchedAddressUpdateCacheMut.RLock()
+	cache, cached := watchedAddressUpdateCache[key]
+	watchedAddressUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			watchedAddressAllColumns,
+			watchedAddressPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update watched_addresses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"watched_addresses\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, watchedAddressPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(watchedAddressType, watchedAddressMapping, append(wl, watchedAddressPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update watched_addresses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for watched_addresses")
+	}
+
+	if !cached {
+		watchedAddressUpdateCacheMut.Lock()
+		watchedAddressUpdateCache[key] = cache
+		watchedAddressUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q watchedAddressQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for watched_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for watched_addresses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WatchedAddressSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchedAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"watched_addresses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, watchedAddressPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in watchedAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all watchedAddress")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WatchedAddress) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no watched_addresses provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchedAddressColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	watchedAddressUpsertCacheMut.RLock()
+	cache, cached := watchedAddressUpsertCache[key]
+	watchedAddressUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			watchedAddressAllColumns,
+			watchedAddressColumnsWithDefault,
+			watchedAddressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			watchedAddressAllColumns,
+			watchedAddressPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert watched_addresses, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(watchedAddressAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(watchedAddressPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert watched_addresses, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(watchedAddressPrimaryKeyColumns))
+			copy(conflict, watchedAddressPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"watched_addresses\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(watchedAddressType, watchedAddressMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(watchedAddressType, watchedAddressMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert watched_addresses")
+	}
+
+	if !cached {
+		watchedAddressUpsertCacheMut.Lock()
+		watchedAddressUpsertCache[key] = cache
+		watchedAddressUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WatchedAddress record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WatchedAddress) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WatchedAddress provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), watchedAddressPrimaryKeyMapping)
+	sql := "DELETE FROM \"watched_addresses\" WHERE \"user_id\"=$1 AND \"chain_id\"=$2 AND \"address\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from watched_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for watched_addresses")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q watchedAddressQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no watchedAddressQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watched_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watched_addresses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WatchedAddressSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(watchedAddressBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchedAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"watched_addresses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchedAddressPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchedAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watched_addresses")
+	}
+
+	if len(watchedAddressAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WatchedAddress) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWatchedAddress(ctx, exec, o.UserID, o.ChainID, o.Address)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WatchedAddressSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WatchedAddressSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchedAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"watched_addresses\".* FROM \"watched_addresses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchedAddressPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WatchedAddressSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WatchedAddressExists checks if the WatchedAddress row exists.
+func WatchedAddressExists(ctx context.Context, exec boil.ContextExecutor, userID int, chainID int64, address string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"watched_addresses\" where \"user_id\"=$1 AND \"chain_id\"=$2 AND \"address\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, chainID, address)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, chainID, address)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if watched_addresses exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WatchedAddress row exists.
+func (o *WatchedAddress) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WatchedAddressExists(ctx, exec, o.UserID, o.ChainID, o.Address)
+}
@@ -13,7 +13,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -88,12 +87,9 @@ func NewStore(ctx context.Context, v *viper.Viper) (*Store, error) {
 		log.Info("migration completed successfully")
 	}
 
-	// set the database connection for SQLBoiler
-	boil.SetDB(db)
-
+	// the store passes its connection to SQLBoiler along with the context of each query, no global one is set
 	s := &Store{
-		ctx: ctx,
-		db:  db,
+		db: db,
 	}
 
 	return s, nil
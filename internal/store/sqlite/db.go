@@ -0,0 +1,696 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// streamFetchSize is the number of transactions read at once by the transactions stream
+const streamFetchSize = 500
+
+// Store is the embedded sqlite storage, it shares the models (and their queries) with the postgres one,
+// since sqlite understands the same placeholders, upserts and returning clauses. The store bound to a unit
+// of work runs all of its queries within the db transaction of that unit of work, while the unbound one runs
+// them on the connection pool.
+//
+// The db transactions start deferred, so the unit of work waits for the other writers at its first write;
+// the one reading before that might fail with SQLITE_BUSY, once another writer commits meanwhile.
+type Store struct {
+	db *sql.DB
+	// tx is the db transaction of the unit of work, nil outside of it
+	tx *sql.Tx
+}
+
+// executor returns the db transaction of the unit of work, or the connection pool outside of it
+func (st *Store) executor() boil.ContextExecutor {
+	if st.tx != nil {
+		return st.tx
+	}
+	return st.db
+}
+
+// GetUser returns the user by the username and the (plain text) password, there is no crypt() in sqlite,
+// so the bcrypt hash is checked in Go
+func (st *Store) GetUser(ctx context.Context, username, password string) (*models.User, error) {
+	user, err := models.Users(
+		qm.Select(models.UserColumns.ID, models.UserColumns.Password),
+		qm.Where(models.UserColumns.Username+"=?", username),
+	).One(ctx, st.executor())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &models.User{ID: store.NonAuthenticatedUser}, nil
+		}
+		return nil, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return &models.User{ID: store.NonAuthenticatedUser}, nil
+		}
+		return nil, err
+	}
+
+	return &models.User{ID: user.ID}, nil
+}
+
+// GetAllTransactions returns the page of the stored transactions of the chain, that pass the filter
+func (st *Store) GetAllTransactions(ctx context.Context, chainID int64, filter store.Filter, page store.Page) (
+	[]*models.Transaction, error) {
+	mods := append(allTransactionsMods(chainID, filter), pageMods(filter.Sort, page)...)
+	txList, err := models.Transactions(mods...).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select all tx from database: %v", err)
+	}
+
+	return txList, nil
+}
+
+// GetMyTransactions returns the page of the stored transactions of the chain and the user, that pass the filter
+func (st *Store) GetMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter,
+	page store.Page) ([]*models.Transaction, error) {
+	mods := append(myTransactionsMods(chainID, userID, filter), pageMods(filter.Sort, page)...)
+	txList, err := models.Transactions(mods...).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select all tx from database: %v", err)
+	}
+
+	return txList, nil
+}
+
+// StreamAllTransactions streams all stored transactions of the chain, that pass the filter, in the order
+// of the pages
+func (st *Store) StreamAllTransactions(ctx context.Context, chainID int64, filter store.Filter,
+	fn store.StreamFunc) error {
+	return st.streamTransactions(ctx, allTransactionsMods(chainID, filter), filter.Sort, fn)
+}
+
+// StreamMyTransactions streams all stored transactions of the chain and the user, that pass the filter,
+// in the order of the pages
+func (st *Store) StreamMyTransactions(ctx context.Context, chainID int64, userID int, filter store.Filter,
+	fn store.StreamFunc) error {
+	return st.streamTransactions(ctx, myTransactionsMods(chainID, userID, filter), filter.Sort, fn)
+}
+
+// streamTransactions reads the transactions page by page, since there are no server-side cursors in sqlite;
+// the pages are read within a single db transaction, so they all see the same snapshot
+func (st *Store) streamTransactions(ctx context.Context, mods []qm.QueryMod, sort store.Sort,
+	fn store.StreamFunc) error {
+	return st.withTx(ctx, func(tx *Store) error {
+		page := store.Page{Limit: streamFetchSize}
+		for {
+			txList, err := models.Transactions(append(slices.Clip(mods), pageMods(sort, page)...)...).
+				All(ctx, tx.tx)
+			if err != nil {
+				return fmt.Errorf("cannot fetch streamed tx from database: %v", err)
+			}
+			if len(txList) == 0 {
+				return nil
+			}
+			if err = fn(txList); err != nil {
+				return err
+			}
+			if len(txList) < streamFetchSize {
+				return nil
+			}
+			page.Cursor = store.NewCursor(txList[len(txList)-1])
+		}
+	})
+}
+
+// allTransactionsMods selects the transactions of the chain, that pass the filter
+func allTransactionsMods(chainID int64, filter store.Filter) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		models.TransactionWhere.ChainID.EQ(chainID),
+	}
+	return append(mods, filterMods(filter)...)
+}
+
+// myTransactionsMods selects the transactions of the chain and the user, that pass the filter
+func myTransactionsMods(chainID int64, userID int, filter store.Filter) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		qm.InnerJoin(models.TableNames.UserTransactions + " ut on " +
+			"ut." + models.UserTransactionColumns.ChainID + " = " +
+			models.TableNames.Transactions + "." + models.TransactionColumns.ChainID + " and " +
+			"ut." + models.UserTransactionColumns.TXHash + " = " +
+			models.TableNames.Transactions + "." + models.TransactionColumns.TXHash),
+		qm.Where("ut."+models.UserTransactionColumns.UserID+" = ?", userID),
+		models.TransactionWhere.ChainID.EQ(chainID),
+	}
+	return append(mods, filterMods(filter)...)
+}
+
+// valueDigits is the count of the decimal digits of the largest value in wei, the one of uint256
+const valueDigits = 78
+
+// paddedValue is the value of the transaction, stored as decimal text, padded with leading zeros to valueDigits,
+// so the values compare as text exactly the way they compare as numbers; the cast to numeric would turn
+// the values beyond 64-bit integer into floating point, compared only approximately
+func paddedValue() string {
+	return "substr('" + strings.Repeat("0", valueDigits) + "' || " + models.TableNames.Transactions + "." +
+		models.TransactionColumns.Value + ", -" + strconv.Itoa(valueDigits) + ")"
+}
+
+// padValue pads the decimal value the same way as paddedValue, to be compared with it
+func padValue(value string) string {
+	return strings.Repeat("0", max(valueDigits-len(value), 0)) + value
+}
+
+// filterMods turns the conditions of the filter into the where clauses
+func filterMods(filter store.Filter) []qm.QueryMod {
+	column := func(name string) string {
+		return models.TableNames.Transactions + "." + name
+	}
+
+	mods := periodMods(filter.Period)
+	if filter.From != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.FromAddress)+" = ?", filter.From))
+	}
+	if filter.To != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.ToAddress)+" = ?", filter.To))
+	}
+	if filter.Contract != "" {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" = ?", filter.Contract))
+	}
+	if filter.Status != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.TXStatus)+" = ?", *filter.Status))
+	}
+	if filter.FromBlock != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.BlockNumber)+" >= ?", *filter.FromBlock))
+	}
+	if filter.ToBlock != nil {
+		mods = append(mods, qm.Where(column(models.TransactionColumns.BlockNumber)+" <= ?", *filter.ToBlock))
+	}
+	if filter.MinValue != nil {
+		mods = append(mods, qm.Where(paddedValue()+" >= ?", padValue(filter.MinValue.String())))
+	}
+	if filter.MaxValue != nil {
+		mods = append(mods, qm.Where(paddedValue()+" <= ?", padValue(filter.MaxValue.String())))
+	}
+	if filter.ContractCreation != nil {
+		if *filter.ContractCreation {
+			mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" is not null"))
+		} else {
+			mods = append(mods, qm.Where(column(models.TransactionColumns.ContractAddress)+" is null"))
+		}
+	}
+	return mods
+}
+
+// pageMods orders the transactions, and limits them to the page right after the cursor; the ties are broken
+// by the hash and the pending transactions, with null block number, are ordered last by block number
+func pageMods(sort store.Sort, page store.Page) []qm.QueryMod {
+	blockNumber := models.TableNames.Transactions + "." + models.TransactionColumns.BlockNumber
+	value := paddedValue()
+	txHash := models.TableNames.Transactions + "." + models.TransactionColumns.TXHash
+
+	var mods []qm.QueryMod
+	switch sort {
+	case store.SortValueDesc:
+		mods = append(mods, qm.OrderBy(value+" desc, "+txHash+" asc"))
+		if cursor := page.Cursor; cursor != nil {
+			mods = append(mods, qm.Where("("+value+" < ? or ("+value+" = ? and "+txHash+" > ?))",
+				padValue(cursor.Value), padValue(cursor.Value), cursor.TXHash))
+		}
+	default:
+		mods = append(mods, qm.OrderBy(blockNumber+" asc nulls last, "+txHash+" asc"))
+		if cursor := page.Cursor; cursor != nil {
+			if cursor.BlockNumber.Big == nil {
+				mods = append(mods, qm.Where(blockNumber+" is null and "+txHash+" > ?", cursor.TXHash))
+			} else {
+				mods = append(mods, qm.Where("("+blockNumber+" > ? or ("+blockNumber+" = ? and "+txHash+" > ?) or "+
+					blockNumber+" is null)", cursor.BlockNumber, cursor.BlockNumber, cursor.TXHash))
+			}
+		}
+	}
+	if page.Limit > 0 {
+		mods = append(mods, qm.Limit(page.Limit))
+	}
+	return mods
+}
+
+// periodMods limits the transactions by the timestamp of their block, joined only when needed; the timestamps
+// are stored as text along with their zone, so they are compared as julian days
+func periodMods(period store.Period) []qm.QueryMod {
+	if period.IsZero() {
+		return nil
+	}
+
+	transactions := models.TableNames.Transactions
+	mods := []qm.QueryMod{
+		qm.Select(transactions + ".*"),
+		qm.InnerJoin(models.TableNames.Blocks + " b on " +
+			"b." + models.BlockColumns.ChainID + " = " + transactions + "." + models.TransactionColumns.ChainID +
+			" and b." + models.BlockColumns.BlockHash + " = " + transactions + "." + models.TransactionColumns.BlockHash),
+	}
+	if !period.Since.IsZero() {
+		mods = append(mods, qm.Where("julianday(b."+models.BlockColumns.Timestamp+") >= julianday(?)", period.Since))
+	}
+	if !period.Until.IsZero() {
+		mods = append(mods, qm.Where("julianday(b."+models.BlockColumns.Timestamp+") < julianday(?)", period.Until))
+	}
+	return mods
+}
+
+// GetPendingTransactions returns the transactions of all chains that are not mined yet
+func (st *Store) GetPendingTransactions(ctx context.Context) ([]*models.Transaction, error) {
+	txList, err := models.Transactions(
+		models.TransactionWhere.Pending.EQ(true),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select pending tx from database: %v", err)
+	}
+
+	return txList, nil
+}
+
+// GetTransactionsSinceBlock returns the mined transactions of the chain with block number greater than
+// the provided one
+func (st *Store) GetTransactionsSinceBlock(ctx context.Context, chainID int64, blockNumber uint64) (
+	[]*models.Transaction, error) {
+	txList, err := models.Transactions(
+		models.TransactionWhere.ChainID.EQ(chainID),
+		models.TransactionWhere.Pending.EQ(false),
+		qm.Where(models.TransactionColumns.BlockNumber+" > ?", blockNumber),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx from database since block %d: %v", blockNumber, err)
+	}
+
+	return txList, nil
+}
+
+// GetTransactionsByHashes returns the transactions of the chain by their hashes, matched in lower case
+func (st *Store) GetTransactionsByHashes(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.Transaction, error) {
+	hashes := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		hashes = append(hashes, strings.ToLower(txHash))
+	}
+
+	txList, err := models.Transactions(
+		models.TransactionWhere.ChainID.EQ(chainID),
+		models.TransactionWhere.TXHash.IN(hashes),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx from database by provided tx hashes: %v", err)
+	}
+
+	return txList, nil
+}
+
+// GetBlocks returns the blocks of the chain by their hashes
+func (st *Store) GetBlocks(ctx context.Context, chainID int64, blockHashes []string) ([]*models.Block, error) {
+	blockList, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(chainID),
+		models.BlockWhere.BlockHash.IN(blockHashes),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select blocks from database by provided block hashes: %v", err)
+	}
+
+	return blockList, nil
+}
+
+// GetTransactionLogs returns the receipt logs of the chain transactions, ordered by their index
+func (st *Store) GetTransactionLogs(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TransactionLog, error) {
+	logList, err := models.TransactionLogs(
+		models.TransactionLogWhere.ChainID.EQ(chainID),
+		models.TransactionLogWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TransactionLogColumns.TXHash+", "+models.TransactionLogColumns.LogIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx logs from database by provided tx hashes: %v", err)
+	}
+
+	return logList, nil
+}
+
+// GetTransactionTraces returns the call frames of the chain transactions, in the order they were called
+func (st *Store) GetTransactionTraces(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TransactionTrace, error) {
+	traceList, err := models.TransactionTraces(
+		models.TransactionTraceWhere.ChainID.EQ(chainID),
+		models.TransactionTraceWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TransactionTraceColumns.TXHash+", "+models.TransactionTraceColumns.TraceIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select tx traces from database by provided tx hashes: %v", err)
+	}
+
+	return traceList, nil
+}
+
+// GetTokenTransfers returns the token transfers of the chain transactions, ordered by their log
+func (st *Store) GetTokenTransfers(ctx context.Context, chainID int64, txHashes []string) (
+	[]*models.TokenTransfer, error) {
+	transferList, err := models.TokenTransfers(
+		models.TokenTransferWhere.ChainID.EQ(chainID),
+		models.TokenTransferWhere.TXHash.IN(txHashes),
+		qm.OrderBy(models.TokenTransferColumns.TXHash+", "+models.TokenTransferColumns.LogIndex+", "+
+			models.TokenTransferColumns.BatchIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select token transfers from database by provided tx hashes: %v", err)
+	}
+
+	return transferList, nil
+}
+
+// GetMyTokenTransfers returns the token transfers of all the user transactions of the chain, in the order
+// they were mined
+func (st *Store) GetMyTokenTransfers(ctx context.Context, chainID int64, userID int) ([]*models.TokenTransfer, error) {
+	transfers := models.TableNames.TokenTransfers
+	transferList, err := models.TokenTransfers(
+		qm.Select(transfers+".*"),
+		qm.InnerJoin(models.TableNames.UserTransactions+" ut on "+
+			"ut."+models.UserTransactionColumns.ChainID+" = "+transfers+"."+models.TokenTransferColumns.ChainID+
+			" and ut."+models.UserTransactionColumns.TXHash+" = "+transfers+"."+models.TokenTransferColumns.TXHash),
+		qm.InnerJoin(models.TableNames.Transactions+" t on "+
+			"t."+models.TransactionColumns.ChainID+" = "+transfers+"."+models.TokenTransferColumns.ChainID+
+			" and t."+models.TransactionColumns.TXHash+" = "+transfers+"."+models.TokenTransferColumns.TXHash),
+		qm.Where("ut."+models.UserTransactionColumns.UserID+" = ?", userID),
+		models.TokenTransferWhere.ChainID.EQ(chainID),
+		qm.OrderBy("t."+models.TransactionColumns.BlockNumber+", "+
+			transfers+"."+models.TokenTransferColumns.LogIndex+", "+
+			transfers+"."+models.TokenTransferColumns.BatchIndex),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select my token transfers from database: %v", err)
+	}
+
+	return transferList, nil
+}
+
+// InsertTransactions inserts records in blocks, transactions, transaction_logs, token_transfers,
+// transaction_traces and user_transactions tables, all of them within a single unit of work; there is
+// no COPY in sqlite, so the records are inserted one by one
+func (st *Store) InsertTransactions(ctx context.Context, txList []*store.TxRecord, userID int) error {
+	if len(txList) == 0 {
+		return nil
+	}
+
+	err := st.withTx(ctx, func(dbTx *Store) error {
+		for _, record := range txList {
+			if err := dbTx.insertTransaction(ctx, record, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot insert %d txs into the database: %v", len(txList), err)
+	}
+
+	return nil
+}
+
+// insertTransaction inserts the eth TX along with its block, logs, token transfers, call frames and user/TX,
+// within the unit of work of the store
+func (st *Store) insertTransaction(ctx context.Context, record *store.TxRecord, userID int) error {
+	tx := record.Transaction
+
+	// the block goes first, since the transaction references it
+	if err := st.insertBlock(ctx, record.Block); err != nil {
+		return err
+	}
+
+	err := tx.Upsert(ctx, st.tx, true,
+		[]string{models.TransactionColumns.ChainID, models.TransactionColumns.TXHash},
+		boil.Infer(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert tx into the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	if err = st.replaceTransactionLogs(ctx, tx, record.Logs); err != nil {
+		return err
+	}
+	if err = st.replaceTokenTransfers(ctx, tx, record.Transfers); err != nil {
+		return err
+	}
+	if err = st.replaceTransactionTraces(ctx, tx, record.Traces); err != nil {
+		return err
+	}
+	return st.insertUserTransaction(ctx, tx, userID)
+}
+
+// insertBlock stores the block of the mined transaction, unless it is already stored by another one of its
+// transactions
+func (st *Store) insertBlock(ctx context.Context, block *models.Block) error {
+	if block == nil {
+		return nil
+	}
+
+	err := block.Upsert(ctx, st.executor(), false,
+		[]string{models.BlockColumns.ChainID, models.BlockColumns.BlockHash},
+		boil.None(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert block into the database for hash '%s': %v", block.BlockHash, err)
+	}
+
+	return nil
+}
+
+// replaceTransactionLogs stores the current logs of the transaction, the ones of a previous receipt
+// (e.g. before a reorg) are dropped
+func (st *Store) replaceTransactionLogs(ctx context.Context, tx *models.Transaction,
+	logList []*models.TransactionLog) error {
+	_, err := models.TransactionLogs(
+		models.TransactionLogWhere.ChainID.EQ(tx.ChainID),
+		models.TransactionLogWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, st.executor())
+	if err != nil {
+		return fmt.Errorf("cannot delete tx logs from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, txLog := range logList {
+		if err = txLog.Insert(ctx, st.executor(), boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert tx log into the database for hash '%s': %v", tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceTokenTransfers stores the current token transfers of the transaction, like its logs
+func (st *Store) replaceTokenTransfers(ctx context.Context, tx *models.Transaction,
+	transferList []*models.TokenTransfer) error {
+	_, err := models.TokenTransfers(
+		models.TokenTransferWhere.ChainID.EQ(tx.ChainID),
+		models.TokenTransferWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, st.executor())
+	if err != nil {
+		return fmt.Errorf("cannot delete token transfers from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, transfer := range transferList {
+		if err = transfer.Insert(ctx, st.executor(), boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert token transfer into the database for hash '%s': %v", tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceTransactionTraces stores the current call frames of the traced transaction, like its logs; the stored
+// frames are kept when the transaction is not traced, e.g. the tracing got switched off meanwhile
+func (st *Store) replaceTransactionTraces(ctx context.Context, tx *models.Transaction,
+	traceList []*models.TransactionTrace) error {
+	if traceList == nil {
+		return nil
+	}
+
+	_, err := models.TransactionTraces(
+		models.TransactionTraceWhere.ChainID.EQ(tx.ChainID),
+		models.TransactionTraceWhere.TXHash.EQ(tx.TXHash),
+	).DeleteAll(ctx, st.executor())
+	if err != nil {
+		return fmt.Errorf("cannot delete tx traces from the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	for _, trace := range traceList {
+		if err = trace.Insert(ctx, st.executor(), boil.Infer()); err != nil {
+			return fmt.Errorf("cannot insert tx trace into the database for hash '%s': %v", tx.TXHash, err)
+		}
+	}
+
+	return nil
+}
+
+// InsertTransactionsUser inserts record in the join "user_transactions" table if needed
+func (st *Store) InsertTransactionsUser(ctx context.Context, txList []*models.Transaction, userID int) error {
+	for _, tx := range txList {
+		if err := st.insertUserTransaction(ctx, tx, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertUserTransaction adds the user/tx to the join table, unless the user is not authenticated
+// or it is already added
+func (st *Store) insertUserTransaction(ctx context.Context, tx *models.Transaction, userID int) error {
+	if userID == store.NonAuthenticatedUser {
+		return nil
+	}
+
+	userTx := &models.UserTransaction{
+		UserID:  userID,
+		ChainID: tx.ChainID,
+		TXHash:  tx.TXHash,
+	}
+	err := userTx.Upsert(ctx, st.executor(), false, []string{
+		models.UserTransactionColumns.UserID,
+		models.UserTransactionColumns.ChainID,
+		models.UserTransactionColumns.TXHash,
+	}, boil.None(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert tx/user into the database for hash '%s': %v", tx.TXHash, err)
+	}
+
+	return nil
+}
+
+// AddWatchedAddress registers the (lower case) address watched by the user, unless it is already watched
+func (st *Store) AddWatchedAddress(ctx context.Context, watched *models.WatchedAddress) error {
+	err := watched.Upsert(ctx, st.executor(), false, []string{
+		models.WatchedAddressColumns.UserID,
+		models.WatchedAddressColumns.ChainID,
+		models.WatchedAddressColumns.Address,
+	}, boil.None(), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert watched address '%s' into the database: %v", watched.Address, err)
+	}
+
+	return nil
+}
+
+// GetWatchedAddresses returns the addresses watched by all the users on the chain
+func (st *Store) GetWatchedAddresses(ctx context.Context, chainID int64) ([]*models.WatchedAddress, error) {
+	watchedList, err := models.WatchedAddresses(
+		models.WatchedAddressWhere.ChainID.EQ(chainID),
+	).All(ctx, st.executor())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select watched addresses from database: %v", err)
+	}
+
+	return watchedList, nil
+}
+
+// GetBackfillCheckpoint returns the progress of the backfill job, or nil when it is not started yet
+func (st *Store) GetBackfillCheckpoint(ctx context.Context, chainID int64, job string) (
+	*models.BackfillCheckpoint, error) {
+	checkpoint, err := models.FindBackfillCheckpoint(ctx, st.executor(), chainID, job)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot select backfill checkpoint '%s' from database: %v", job, err)
+	}
+
+	return checkpoint, nil
+}
+
+// SaveBackfillCheckpoint stores the progress of the backfill job, replacing the previous one
+func (st *Store) SaveBackfillCheckpoint(ctx context.Context, checkpoint *models.BackfillCheckpoint) error {
+	err := checkpoint.Upsert(ctx, st.executor(), true,
+		[]string{models.BackfillCheckpointColumns.ChainID, models.BackfillCheckpointColumns.Job},
+		boil.Whitelist(models.BackfillCheckpointColumns.NextBlock, models.BackfillCheckpointColumns.UpdatedAt),
+		boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert backfill checkpoint '%s' into the database: %v", checkpoint.Job, err)
+	}
+
+	return nil
+}
+
+// GetContract returns the contract of the chain by its (lower case) address, or nil when its ABI is not uploaded
+func (st *Store) GetContract(ctx context.Context, chainID int64, address string) (*models.Contract, error) {
+	contract, err := models.FindContract(ctx, st.executor(), chainID, address)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot select contract '%s' from database: %v", address, err)
+	}
+
+	return contract, nil
+}
+
+// UpsertContract stores the contract ABI, replacing the previously uploaded one
+func (st *Store) UpsertContract(ctx context.Context, contract *models.Contract) error {
+	err := contract.Upsert(ctx, st.executor(), true,
+		[]string{models.ContractColumns.ChainID, models.ContractColumns.Address},
+		boil.Whitelist(models.ContractColumns.Abi), boil.Infer())
+	if err != nil {
+		return fmt.Errorf("cannot insert contract '%s' into the database: %v", contract.Address, err)
+	}
+
+	return nil
+}
+
+// WithTx runs the unit of work within a single db transaction, committed unless the unit of work fails;
+// the nested units of work join the outer one
+func (st *Store) WithTx(ctx context.Context, fn func(tx store.StorageProvider) error) error {
+	return st.withTx(ctx, func(tx *Store) error {
+		return fn(tx)
+	})
+}
+
+// withTx runs the unit of work on the store bound to it, the store already bound to one just joins it
+func (st *Store) withTx(ctx context.Context, fn func(tx *Store) error) error {
+	if st.tx != nil {
+		return fn(st)
+	}
+
+	tx, err := st.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.end(false)
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		_ = tx.end(false)
+		return err
+	}
+	return tx.end(true)
+}
+
+// begin starts the unit of work and returns the store bound to it
+func (st *Store) begin(ctx context.Context) (*Store, error) {
+	dbTx, err := st.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start db transaction: %v", err)
+	}
+
+	return &Store{db: st.db, tx: dbTx}, nil
+}
+
+// end commits or rolls back the db transaction of the unit of work
+func (st *Store) end(commit bool) error {
+	if commit {
+		if err := st.tx.Commit(); err != nil {
+			return fmt.Errorf("cannot commit db transaction: %v", err)
+		}
+		return nil
+	}
+	return st.tx.Rollback()
+}
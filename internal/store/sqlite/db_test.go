@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/storetest"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// backend runs the storage test suite against the sqlite database file of the test
+type backend struct {
+	store *Store
+}
+
+func (b *backend) Store() store.StorageProvider {
+	return b.store
+}
+
+// Begin binds the test to the unit of work, the test data is prepared within the same db transaction
+func (b *backend) Begin(ctx context.Context) (store.StorageProvider, boil.ContextExecutor, error) {
+	tx, err := b.store.begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, tx.tx, nil
+}
+
+func (b *backend) Rollback(st store.StorageProvider) error {
+	return st.(*Store).end(false)
+}
+
+func (b *backend) Executor() boil.ContextExecutor {
+	return b.store.db
+}
+
+// TestStorageTestSuite runs on a file, rather than in memory, since the concurrent units of work need
+// connections of their own
+func TestStorageTestSuite(t *testing.T) {
+	vp := cmd.NewViper()
+	vp.Set(cmd.SQLitePath, filepath.Join(t.TempDir(), "test.db"))
+
+	cmd.LogInit("fatal")
+	st, err := NewStore(context.Background(), vp)
+	if err != nil {
+		t.Fatalf("cannot initialize database: %v", err)
+	}
+	defer func() {
+		_ = st.db.Close()
+	}()
+
+	storetest.Run(t, &backend{store: st})
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	moderncsqlite "modernc.org/sqlite"
+)
+
+// driverConn is the connection of the sqlite driver, along with the optional interfaces database/sql makes use of
+type driverConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+// decimalValuer is the SQLBoiler decimal, database/sql passes it to the driver as it is, since it decomposes itself
+type decimalValuer interface {
+	driver.Valuer
+	Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32)
+}
+
+// decimalConn binds the decimals by their text, the sqlite driver binds only the base values
+type decimalConn struct {
+	driverConn
+}
+
+// CheckNamedValue converts the decimals, all the other values are left to the default conversion
+func (c decimalConn) CheckNamedValue(nv *driver.NamedValue) error {
+	valuer, ok := nv.Value.(decimalValuer)
+	if !ok {
+		return driver.ErrSkip
+	}
+
+	value, err := valuer.Value()
+	if err != nil {
+		return err
+	}
+	nv.Value = value
+	return nil
+}
+
+// connector opens the sqlite connections, that bind the decimals
+type connector struct {
+	dsn string
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	conn, err := c.Driver().Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, ok := conn.(driverConn)
+	if !ok {
+		_ = conn.Close()
+		return nil, fmt.Errorf("unexpected sqlite connection %T", conn)
+	}
+	return decimalConn{driverConn: dc}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return &moderncsqlite.Driver{}
+}
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS backfill_checkpoints;
+DROP TABLE IF EXISTS watched_addresses;
+DROP TABLE IF EXISTS transaction_traces;
+DROP TABLE IF EXISTS token_transfers;
+DROP TABLE IF EXISTS contracts;
+DROP TABLE IF EXISTS transaction_logs;
+DROP TABLE IF EXISTS user_transactions;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS blocks;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,162 @@
+-- the schema of all the postgres migrations at once, the sqlite storage has no data stored before them;
+-- the numeric and timestamp columns are declared by the types the driver converts them back from
+CREATE TABLE IF NOT EXISTS users
+(
+    id       INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(20) UNIQUE NOT NULL,
+    password TEXT NOT NULL -- stores the bcrypt hashed, salted passwords
+);
+
+-- the passwords are hashed in Go, since there is no crypt() in sqlite
+INSERT INTO users (username, password)
+VALUES
+    ('alice', '$2a$10$VfYVdfmHx89nYfBDhIlEd.Pqrusg56MHclBQXHdFxvoL0Ic3FHkgG'),
+    ('bob', '$2a$10$6Jf1ra4cpsLQSFALxW7qCuFB3hUd7qs6qJBy5h.JUKb0uq9BO.Qzy'),
+    ('carol', '$2a$10$wJ6oWxy2Ip8jGLZV7Q5p6.sluNBH47gvrzjlKuk1P/dQzqF5EUD1q'),
+    ('dave', '$2a$10$gTVgXHJDIPAaVe8TNXhWv.J.2X86FwwggnGUeBijZdZLB26ZiSA2S');
+
+-- the blocks are immutable by their hash, so the ones orphaned by a reorg are kept as they are
+CREATE TABLE IF NOT EXISTS blocks
+(
+    chain_id         BIGINT      NOT NULL,
+    block_hash       VARCHAR(66) NOT NULL,
+    block_number     INTEGER     NOT NULL,
+    parent_hash      VARCHAR(66) NOT NULL,
+    timestamp        DATETIME    NOT NULL,
+    base_fee_per_gas TEXT, -- missing before London
+    miner            VARCHAR(42) NOT NULL,
+    PRIMARY KEY (chain_id, block_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blocks_timestamp ON blocks (chain_id, timestamp);
+
+-- pending transactions are stored before they are mined, so they don't have block, status and receipt yet
+CREATE TABLE IF NOT EXISTS transactions
+(
+    chain_id                 BIGINT      NOT NULL,
+    tx_hash                  VARCHAR(66) NOT NULL,
+    tx_status                INT,
+    block_hash               VARCHAR(66),
+    block_number             INTEGER,
+    from_address             VARCHAR(42) NOT NULL,
+    to_address               VARCHAR(42),
+    contract_address         VARCHAR(42),
+    logs_count               BIGINT      NOT NULL,
+    input                    TEXT        NOT NULL,
+    value                    TEXT        NOT NULL,
+    pending                  BOOLEAN     NOT NULL DEFAULT FALSE,
+    nonce                    BIGINT,
+    tx_type                  INT,
+    gas_limit                BIGINT,
+    gas_price                TEXT,
+    max_fee_per_gas          TEXT,
+    max_priority_fee_per_gas TEXT,
+    max_fee_per_blob_gas     TEXT,
+    access_list              TEXT,
+    gas_used                 BIGINT,
+    effective_gas_price      TEXT,
+    blob_gas_used            BIGINT,
+    blob_gas_price           TEXT,
+    verified                 BOOLEAN     NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (chain_id, tx_hash),
+    FOREIGN KEY (chain_id, block_hash) REFERENCES blocks (chain_id, block_hash)
+);
+
+-- partial index for the receipt refresher, that polls only the pending transactions
+CREATE INDEX IF NOT EXISTS idx_transactions_pending ON transactions (tx_hash) WHERE pending;
+-- the reorg watcher re-validates only the most recent (not yet confirmed) mined transactions
+CREATE INDEX IF NOT EXISTS idx_transactions_block_number ON transactions (chain_id, block_number) WHERE NOT pending;
+-- the transactions are listed page by page, ordered by block number and hash within the chain
+CREATE INDEX IF NOT EXISTS idx_transactions_keyset ON transactions (chain_id, block_number, tx_hash);
+
+CREATE TABLE IF NOT EXISTS user_transactions
+(
+    user_id  INT         NOT NULL,
+    chain_id BIGINT      NOT NULL,
+    tx_hash  VARCHAR(66) NOT NULL,
+    PRIMARY KEY (user_id, chain_id, tx_hash),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_transactions_user_id ON user_transactions (user_id);
+
+-- the topics are stored in the text form of the postgres array
+CREATE TABLE IF NOT EXISTS transaction_logs
+(
+    chain_id  BIGINT      NOT NULL,
+    tx_hash   VARCHAR(66) NOT NULL,
+    log_index INT         NOT NULL,
+    address   VARCHAR(42) NOT NULL,
+    topics    TEXT        NOT NULL,
+    data      TEXT        NOT NULL,
+    removed   BOOLEAN     NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (chain_id, tx_hash, log_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS contracts
+(
+    chain_id BIGINT      NOT NULL,
+    address  VARCHAR(42) NOT NULL,
+    abi      TEXT        NOT NULL,
+    PRIMARY KEY (chain_id, address)
+);
+
+CREATE TABLE IF NOT EXISTS token_transfers
+(
+    chain_id      BIGINT      NOT NULL,
+    tx_hash       VARCHAR(66) NOT NULL,
+    log_index     INT         NOT NULL,
+    -- position of the transfer within TransferBatch event, 0 for the other events
+    batch_index   INT         NOT NULL DEFAULT 0,
+    standard      VARCHAR(8)  NOT NULL,
+    token_address VARCHAR(42) NOT NULL,
+    operator      VARCHAR(42),
+    from_address  VARCHAR(42) NOT NULL,
+    to_address    VARCHAR(42) NOT NULL,
+    token_id      TEXT,
+    amount        TEXT        NOT NULL,
+    PRIMARY KEY (chain_id, tx_hash, log_index, batch_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS transaction_traces
+(
+    chain_id     BIGINT      NOT NULL,
+    tx_hash      VARCHAR(66) NOT NULL,
+    trace_index  INT         NOT NULL,
+    call_type    VARCHAR(16) NOT NULL,
+    from_address VARCHAR(42) NOT NULL,
+    to_address   VARCHAR(42),
+    value        TEXT        NOT NULL,
+    gas          BIGINT      NOT NULL,
+    error        TEXT,
+    depth        INT         NOT NULL,
+    PRIMARY KEY (chain_id, tx_hash, trace_index),
+    FOREIGN KEY (chain_id, tx_hash) REFERENCES transactions (chain_id, tx_hash) ON DELETE CASCADE
+);
+
+-- the addresses are stored in lower case, the ingested blocks are matched against them
+CREATE TABLE IF NOT EXISTS watched_addresses
+(
+    user_id  INT         NOT NULL,
+    chain_id BIGINT      NOT NULL,
+    address  VARCHAR(42) NOT NULL,
+    PRIMARY KEY (user_id, chain_id, address),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_watched_addresses_chain_id ON watched_addresses (chain_id);
+
+-- progress of the backfill runs, the job identifies the block range and the address filter of the run
+CREATE TABLE IF NOT EXISTS backfill_checkpoints
+(
+    chain_id   BIGINT      NOT NULL,
+    job        VARCHAR(64) NOT NULL,
+    from_block BIGINT      NOT NULL,
+    to_block   BIGINT      NOT NULL,
+    next_block BIGINT      NOT NULL,
+    updated_at DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (chain_id, job)
+);
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"ethereum-fetcher/cmd"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// migrations are embedded, so that the binary runs the demo without the source tree next to it
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// NewStore provides a store implementation through *sqlite.Store type, the database file is created
+// when it does not exist yet
+func NewStore(ctx context.Context, v *viper.Viper) (*Store, error) {
+	db := sql.OpenDB(connector{dsn: dataSourceName(v.GetString(cmd.SQLitePath))})
+	// query the database to early catch issues with the file
+	if err := db.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("cannot open sqlite database: %v", err)
+	}
+
+	// max 25 open connections, sqlite still runs a single writer at a time
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(25)
+
+	driver, err := migratesqlite.WithInstance(db, &migratesqlite.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create sqlite driver: %v", err)
+	}
+
+	source, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read embedded migrations: %v", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create migrate instance: %v", err)
+	}
+
+	// Run the migration
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, fmt.Errorf("migration failed: %v", err)
+	} else if errors.Is(err, migrate.ErrNoChange) {
+		log.Info("no migration needed, database is up to date")
+	} else {
+		log.Info("migration completed successfully")
+	}
+
+	return &Store{db: db}, nil
+}
+
+// dataSourceName sets up each connection of the pool: the foreign keys are enforced, the readers don't block
+// the writer (WAL), the writers wait for each other instead of failing with SQLITE_BUSY, and the times are
+// written in the format sqlite date functions understand
+func dataSourceName(path string) string {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Add("_pragma", "busy_timeout(10000)")
+	query.Set("_time_format", "sqlite")
+	return "file:" + path + "?" + query.Encode()
+}
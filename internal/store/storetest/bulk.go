@@ -0,0 +1,101 @@
+package storetest
+
+import (
+	"context"
+	"fmt"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func (s *StorageTestSuite) TestInsertTransactionsBulk() {
+	r := s.Require()
+
+	user := mockUser(-15)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	records := MockBulkRecords(1, 30)
+
+	// the duplicate record of the same transaction is stored once
+	err = s.st.InsertTransactions(s.ctx, append(records, records[0]), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get my transactions")
+	r.Len(myList, len(records))
+
+	hashes := txHashes(myList)
+	logList, err := s.st.GetTransactionLogs(s.ctx, cmd.SepoliaChainID, hashes)
+	r.Nil(err, "fail to get transaction logs")
+	r.Len(logList, len(records))
+	traceList, err := s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, hashes)
+	r.Nil(err, "fail to get transaction traces")
+	r.Len(traceList, len(records))
+
+	// stored again within the same unit of work, the logs are replaced, while the traces of the not traced
+	// transactions are kept
+	for _, record := range records {
+		record.Logs = nil
+		record.Traces = nil
+	}
+	err = s.st.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions again")
+
+	logList, err = s.st.GetTransactionLogs(s.ctx, cmd.SepoliaChainID, hashes)
+	r.Nil(err, "fail to get transaction logs")
+	r.Empty(logList)
+	traceList, err = s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, hashes)
+	r.Nil(err, "fail to get transaction traces")
+	r.Len(traceList, len(records))
+}
+
+// MockBulkRecords returns the records of the distinct transactions, 10 per block, each one with a log and a call
+// frame; the seed keeps the hashes of the separate calls apart
+func MockBulkRecords(seed, size int) []*store.TxRecord {
+	records := make([]*store.TxRecord, 0, size)
+	for i := 0; i < size; i++ {
+		number := int64(9000000 + seed*size/10 + i/10)
+		tx := &models.Transaction{
+			ChainID:     cmd.SepoliaChainID,
+			TXHash:      fmt.Sprintf("0x%032x%032x", seed, i),
+			TXStatus:    null.IntFrom(1),
+			BlockHash:   null.StringFrom(fmt.Sprintf("0x%064x", number)),
+			BlockNumber: types.NewNullDecimal(decimal.New(number, 0)),
+			FromAddress: "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
+			ToAddress:   null.StringFrom("0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
+			LogsCount:   1,
+			Input:       "0x",
+			Value:       "500000000000000000",
+			GasUsed:     null.Int64From(21000),
+		}
+		records = append(records, &store.TxRecord{
+			Transaction: tx,
+			Block:       mockBlock(tx),
+			Logs:        mockTransactionLogs(tx),
+			Traces:      mockTransactionTraces(tx)[:1],
+		})
+	}
+	return records
+}
+
+// DeleteRecords removes the committed records, the dependent rows go first
+func DeleteRecords(ctx context.Context, exec boil.ContextExecutor, records []*store.TxRecord) {
+	var hashes, blockHashes []string
+	for _, record := range records {
+		hashes = append(hashes, record.TXHash)
+		blockHashes = append(blockHashes, record.Block.BlockHash)
+	}
+
+	_, _ = models.TransactionLogs(models.TransactionLogWhere.TXHash.IN(hashes)).DeleteAll(ctx, exec)
+	_, _ = models.TransactionTraces(models.TransactionTraceWhere.TXHash.IN(hashes)).DeleteAll(ctx, exec)
+	_, _ = models.UserTransactions(models.UserTransactionWhere.TXHash.IN(hashes)).DeleteAll(ctx, exec)
+	_, _ = models.Transactions(models.TransactionWhere.TXHash.IN(hashes)).DeleteAll(ctx, exec)
+	_, _ = models.Blocks(models.BlockWhere.BlockHash.IN(blockHashes)).DeleteAll(ctx, exec)
+}
@@ -0,0 +1,897 @@
+// Package storetest provides the test suite, run against each one of the storage backends.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/store"
+	"ethereum-fetcher/internal/store/pg/models"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// Backend is the storage under test, along with the means to prepare the test data
+type Backend interface {
+	// Store returns the storage, whose units of work are committed
+	Store() store.StorageProvider
+	// Begin starts the db transaction of the test, the returned storage and executor run within it
+	Begin(ctx context.Context) (store.StorageProvider, boil.ContextExecutor, error)
+	// Rollback rolls back the db transaction of the test
+	Rollback(st store.StorageProvider) error
+	// Executor returns the database handle outside of the db transaction of the test
+	Executor() boil.ContextExecutor
+}
+
+// StorageTestSuite is added to satisfy the following requirement:
+//
+// "Create a unit test suite that proves that:
+//   - Your database can store and retrieve data"
+//
+// In order to do that, we are using DB transaction Begin/Rollback testing strategy with the actual database.
+// Each test from the suite creates DB transaction in the beginning and ends with Rollback.
+// The suite is run by each one of the backends, with the Backend set.
+type StorageTestSuite struct {
+	suite.Suite
+	Backend Backend
+
+	ctx context.Context
+	// store is the unbound storage, while st and exec are bound to the db transaction of the test
+	store store.StorageProvider
+	st    store.StorageProvider
+	exec  boil.ContextExecutor
+}
+
+// this function executes before the test suite begins execution
+func (s *StorageTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.store = s.Backend.Store()
+}
+
+// this function executes before each test case
+func (s *StorageTestSuite) SetupTest() {
+	st, exec, err := s.Backend.Begin(s.ctx)
+	if err != nil {
+		s.FailNow("cannot start tx")
+	}
+	s.st = st
+	s.exec = exec
+}
+
+// this function executes after each test case
+func (s *StorageTestSuite) TearDownTest() {
+	err := s.Backend.Rollback(s.st)
+	if err != nil {
+		s.FailNow("cannot rollback tx")
+	}
+}
+
+func (s *StorageTestSuite) TestGetTransactionsByHashes() {
+	txList := mockEthereumTransactions()
+	// the receipt of the first transaction is proven by its block
+	txList[0].Verified = true
+
+	r := s.Require()
+
+	user := mockUser(-1)
+
+	// create test user -1
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// insert couple ethereum transactions under that user
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	// check whether those transactions are available under that user
+	myList, err := s.st.GetTransactionsByHashes(s.ctx, cmd.SepoliaChainID, []string{txList[0].TXHash, txList[1].TXHash})
+	r.Nil(err, "fail to get my transactions for the user")
+
+	foundCnt := containsTransactions(myList, txList)
+	r.Equal(foundCnt, len(txList), "transactions cannot be found")
+
+	for _, tx := range myList {
+		r.Equal(tx.TXHash == txList[0].TXHash, tx.Verified, "verified flag of '%s' is not read back", tx.TXHash)
+	}
+}
+
+func (s *StorageTestSuite) TestGetAllTransactions() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-1)
+
+	// create test user
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// and insert couple ethereum transactions under that user
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	// now get all transactions independently of any user
+	allList, err := s.st.GetAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get all transactions")
+
+	foundCnt := containsTransactions(allList, txList)
+
+	r.Equal(foundCnt, len(txList), "transactions cannot be found")
+}
+
+func (s *StorageTestSuite) TestGetMyTransactions() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-5)
+
+	// create test user
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// and insert couple tx under that user
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	// now fetch only those txs that are "mine"
+	allList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get my transactions for the user")
+
+	foundCnt := containsTransactions(allList, txList)
+
+	r.Equal(foundCnt, len(txList), "transactions cannot be found")
+}
+
+func (s *StorageTestSuite) TestGetTransactionsForPeriod() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-7)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	records := txRecords(txList)
+	err = s.st.InsertTransactions(s.ctx, records, user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	// the second transaction is mined in an earlier block
+	since, until := records[1].Block.Timestamp, records[0].Block.Timestamp
+	tests := []struct {
+		name   string
+		period store.Period
+		want   []*models.Transaction
+	}{
+		{name: "since", period: store.Period{Since: until}, want: txList[:1]},
+		{name: "until", period: store.Period{Until: until}, want: txList[1:]},
+		{name: "since until", period: store.Period{Since: since, Until: until.Add(time.Second)}, want: txList},
+		{name: "empty", period: store.Period{Since: until.Add(time.Second)}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			allList, err := s.st.GetAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{Period: tt.period}, store.Page{})
+			r.Nil(err, "fail to get all transactions")
+			r.Equal(len(tt.want), containsTransactions(allList, txList))
+			r.Equal(len(tt.want), containsTransactions(allList, tt.want))
+
+			myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{Period: tt.period},
+				store.Page{})
+			r.Nil(err, "fail to get my transactions")
+			r.Len(myList, len(tt.want))
+			r.Equal(len(tt.want), containsTransactions(myList, tt.want))
+		})
+	}
+
+	blockList, err := s.st.GetBlocks(s.ctx, cmd.SepoliaChainID, []string{txList[0].BlockHash.String})
+	r.Nil(err, "fail to get blocks")
+	r.Len(blockList, 1)
+	r.True(until.Equal(blockList[0].Timestamp))
+}
+
+func (s *StorageTestSuite) TestGetTransactionsPages() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-9)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// the first transaction is not mined yet, so it goes after the second one
+	txList[0].Pending = true
+	txList[0].TXStatus = null.Int{}
+	txList[0].BlockHash = null.String{}
+	txList[0].BlockNumber = types.NullDecimal{}
+
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	tests := []struct {
+		name string
+		page store.Page
+		want []string
+	}{
+		{name: "unlimited", page: store.Page{}, want: []string{txList[1].TXHash, txList[0].TXHash}},
+		{name: "first page", page: store.Page{Limit: 1}, want: []string{txList[1].TXHash}},
+		{name: "after mined", page: store.Page{Limit: 1, Cursor: store.NewCursor(txList[1])},
+			want: []string{txList[0].TXHash}},
+		{name: "after pending", page: store.Page{Limit: 1, Cursor: store.NewCursor(txList[0])}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{}, tt.page)
+			r.Nil(err, "fail to get my transactions")
+			r.Equal(tt.want, txHashes(myList))
+		})
+	}
+}
+
+func (s *StorageTestSuite) TestGetTransactionsFiltered() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-13)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// the second transaction creates a contract
+	txList[1].ToAddress = null.String{}
+	txList[1].ContractAddress = null.StringFrom("0x4c16D8C078eF6B56700C1BE19a336915962df072")
+
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	success, failure, creation := 1, 0, true
+	fromBlock, toBlock := uint64(5703000), uint64(5702816)
+	tests := []struct {
+		name   string
+		filter store.Filter
+		want   []string
+	}{
+		{name: "from", filter: store.Filter{From: txList[0].FromAddress}, want: []string{txList[0].TXHash}},
+		{name: "to", filter: store.Filter{To: txList[0].ToAddress.String}, want: []string{txList[0].TXHash}},
+		{name: "contract", filter: store.Filter{Contract: txList[1].ContractAddress.String},
+			want: []string{txList[1].TXHash}},
+		{name: "success", filter: store.Filter{Status: &success}, want: []string{txList[1].TXHash, txList[0].TXHash}},
+		{name: "failure", filter: store.Filter{Status: &failure}},
+		{name: "from block", filter: store.Filter{FromBlock: &fromBlock}, want: []string{txList[0].TXHash}},
+		{name: "to block", filter: store.Filter{ToBlock: &toBlock}, want: []string{txList[1].TXHash}},
+		{name: "min value", filter: store.Filter{MinValue: big.NewInt(1)}, want: []string{txList[0].TXHash}},
+		{name: "max value", filter: store.Filter{MaxValue: big.NewInt(0)}, want: []string{txList[1].TXHash}},
+		{name: "contract creation", filter: store.Filter{ContractCreation: &creation},
+			want: []string{txList[1].TXHash}},
+		{name: "sort by value", filter: store.Filter{Sort: store.SortValueDesc},
+			want: []string{txList[0].TXHash, txList[1].TXHash}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, tt.filter, store.Page{})
+			r.Nil(err, "fail to get my transactions")
+			r.Equal(tt.want, txHashes(myList))
+		})
+	}
+
+	// the page sorted by value goes on right after the value of the cursor
+	page := store.Page{Limit: 1, Cursor: store.NewCursor(txList[0])}
+	myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{Sort: store.SortValueDesc},
+		page)
+	r.Nil(err, "fail to get my transactions")
+	r.Equal([]string{txList[1].TXHash}, txHashes(myList))
+}
+
+func (s *StorageTestSuite) TestGetTransactionsByLargeValue() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-15)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// the values beyond 64-bit integer, different only in the digits beyond the precision of the floating point
+	txList[0].Value = "100000000000000000000000000001"
+	txList[1].Value = "100000000000000000000000000000"
+
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	minValue, _ := new(big.Int).SetString(txList[0].Value, 10)
+	maxValue, _ := new(big.Int).SetString(txList[1].Value, 10)
+	tests := []struct {
+		name   string
+		filter store.Filter
+		page   store.Page
+		want   []string
+	}{
+		{name: "min value", filter: store.Filter{MinValue: minValue}, want: []string{txList[0].TXHash}},
+		{name: "max value", filter: store.Filter{MaxValue: maxValue}, want: []string{txList[1].TXHash}},
+		{name: "min and max value", filter: store.Filter{MinValue: big.NewInt(99), MaxValue: minValue},
+			want: []string{txList[1].TXHash, txList[0].TXHash}},
+		{name: "sort by value", filter: store.Filter{Sort: store.SortValueDesc},
+			want: []string{txList[0].TXHash, txList[1].TXHash}},
+		{name: "sort by value after cursor", filter: store.Filter{Sort: store.SortValueDesc},
+			page: store.Page{Cursor: store.NewCursor(txList[0])}, want: []string{txList[1].TXHash}},
+		{name: "sort by value after last cursor", filter: store.Filter{Sort: store.SortValueDesc},
+			page: store.Page{Cursor: store.NewCursor(txList[1])}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, tt.filter, tt.page)
+			r.Nil(err, "fail to get my transactions")
+			r.Equal(tt.want, txHashes(myList))
+		})
+	}
+}
+
+func (s *StorageTestSuite) TestStreamTransactions() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-11)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), user.ID)
+	r.Nil(err, "fail to insert transactions")
+
+	var streamed []*models.Transaction
+	err = s.st.StreamMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{},
+		func(chunk []*models.Transaction) error {
+			streamed = append(streamed, chunk...)
+			return nil
+		})
+	r.Nil(err, "fail to stream my transactions")
+	r.Equal([]string{txList[1].TXHash, txList[0].TXHash}, txHashes(streamed))
+
+	// the error of the consumer stops the stream, and the cursor is released for the next one
+	errStop := errors.New("stop")
+	err = s.st.StreamAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{}, func([]*models.Transaction) error {
+		return errStop
+	})
+	r.ErrorIs(err, errStop)
+
+	allList, err := s.st.GetAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get all transactions")
+	streamed = streamed[:0]
+	err = s.st.StreamAllTransactions(s.ctx, cmd.SepoliaChainID, store.Filter{}, func(chunk []*models.Transaction) error {
+		streamed = append(streamed, chunk...)
+		return nil
+	})
+	r.Nil(err, "fail to stream all transactions")
+	r.Equal(txHashes(allList), txHashes(streamed))
+}
+
+func (s *StorageTestSuite) TestGetUser() {
+	r := s.Require()
+
+	user := mockUser(-2)
+
+	// plain text user password
+	pwd := user.Password
+
+	// hash the password with salt
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	r.Nilf(err, "fail generating bcrypt hash: %v", err)
+	user.Password = string(hashedPassword)
+
+	// insert the user with hashed password
+	err = user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// get the user by provided plain text username ana password
+	freshUser, err := s.st.GetUser(s.ctx, user.Username, pwd)
+	r.Nil(err, "fail to get the user")
+
+	r.Equal(freshUser.ID, user.ID, "user cannot be found")
+}
+
+func (s *StorageTestSuite) TestInsertTransactionsUser() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-3)
+
+	// insert the user
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// store the ethereum transactions without "attaching" user to them
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList), store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions")
+
+	// verify that NO user_transactions records were created
+	myList, err := s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get my transactions for the user")
+
+	foundCnt := containsTransactions(myList, txList)
+	r.Equal(foundCnt, 0, "unexpected transactions were found")
+
+	// later, add the respective records to the join table
+	err = s.st.InsertTransactionsUser(s.ctx, txList, user.ID)
+	r.Nil(err, "fail to insert user_transactions records")
+
+	// verify that those records are there (they should appear as "my" txs)
+	myList, err = s.st.GetMyTransactions(s.ctx, cmd.SepoliaChainID, user.ID, store.Filter{}, store.Page{})
+	r.Nil(err, "fail to get my transactions for the user")
+
+	foundCnt = containsTransactions(myList, txList)
+	r.Equal(foundCnt, len(txList), "transactions cannot be found")
+}
+
+func (s *StorageTestSuite) TestGetPendingTransactions() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	// store the second transaction as not mined yet
+	txList[1].Pending = true
+	txList[1].TXStatus = null.Int{}
+	txList[1].BlockHash = null.String{}
+	txList[1].BlockNumber = types.NullDecimal{}
+
+	err := s.st.InsertTransactions(s.ctx, txRecords(txList), store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions")
+
+	pendingList, err := s.st.GetPendingTransactions(s.ctx)
+	r.Nil(err, "fail to get pending transactions")
+	r.Equal(1, containsTransactions(pendingList, txList), "only the pending transaction must be found")
+
+	// once mined, the transaction is upgraded in place
+	mined := mockEthereumTransactions()[1]
+	err = s.st.InsertTransactions(s.ctx, txRecords([]*models.Transaction{mined}), store.NonAuthenticatedUser)
+	r.Nil(err, "fail to upgrade the pending transaction")
+
+	pendingList, err = s.st.GetPendingTransactions(s.ctx)
+	r.Nil(err, "fail to get pending transactions")
+	r.Equal(0, containsTransactions(pendingList, txList), "mined transaction cannot be pending")
+}
+
+func (s *StorageTestSuite) TestTransactionsPerChain() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-6)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// the same transaction hash stored for another chain
+	otherChain := *txList[0]
+	otherChain.ChainID = 1
+
+	err = s.st.InsertTransactions(s.ctx, txRecords([]*models.Transaction{txList[0], &otherChain}), user.ID)
+	r.Nil(err, "fail to insert transactions of both chains")
+
+	for _, chainID := range []int64{cmd.SepoliaChainID, 1} {
+		myList, err := s.st.GetTransactionsByHashes(s.ctx, chainID, []string{txList[0].TXHash})
+		r.Nil(err, "fail to get transactions by hashes")
+		r.Len(myList, 1)
+		r.Equal(chainID, myList[0].ChainID)
+
+		myList, err = s.st.GetMyTransactions(s.ctx, chainID, user.ID, store.Filter{}, store.Page{})
+		r.Nil(err, "fail to get my transactions for the user")
+		r.Len(myList, 1)
+		r.Equal(chainID, myList[0].ChainID)
+	}
+}
+
+func (s *StorageTestSuite) TestGetTransactionLogs() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	records := txRecords(txList)
+	records[1].Logs = mockTransactionLogs(txList[1])
+
+	err := s.st.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions with logs")
+
+	logList, err := s.st.GetTransactionLogs(s.ctx, cmd.SepoliaChainID, []string{txList[0].TXHash, txList[1].TXHash})
+	r.Nil(err, "fail to get transaction logs")
+	r.Len(logList, 1)
+	r.Equal(records[1].Logs[0].Topics, logList[0].Topics)
+
+	// the logs are replaced along with the transaction, e.g. once it got reorged out
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList[1:]), store.NonAuthenticatedUser)
+	r.Nil(err, "fail to upsert the transaction")
+
+	logList, err = s.st.GetTransactionLogs(s.ctx, cmd.SepoliaChainID, []string{txList[1].TXHash})
+	r.Nil(err, "fail to get transaction logs")
+	r.Empty(logList, "previous logs must be dropped")
+}
+
+func (s *StorageTestSuite) TestGetTransactionTraces() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	records := txRecords(txList)
+	records[1].Traces = mockTransactionTraces(txList[1])
+
+	err := s.st.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions with traces")
+
+	traceList, err := s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, []string{txList[0].TXHash, txList[1].TXHash})
+	r.Nil(err, "fail to get transaction traces")
+	r.Len(traceList, 2)
+	r.Equal(0, traceList[0].Depth)
+	r.Equal("1000", traceList[1].Value)
+
+	// the stored traces are kept, when the transaction is stored again without tracing
+	err = s.st.InsertTransactions(s.ctx, txRecords(txList[1:]), store.NonAuthenticatedUser)
+	r.Nil(err, "fail to upsert the transaction")
+
+	traceList, err = s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, []string{txList[1].TXHash})
+	r.Nil(err, "fail to get transaction traces")
+	r.Len(traceList, 2, "previous traces must be kept")
+
+	// and replaced, when it is traced again
+	records = txRecords(txList[1:])
+	records[0].Traces = mockTransactionTraces(txList[1])[:1]
+	err = s.st.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+	r.Nil(err, "fail to upsert the transaction")
+
+	traceList, err = s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, []string{txList[1].TXHash})
+	r.Nil(err, "fail to get transaction traces")
+	r.Len(traceList, 1, "previous traces must be replaced")
+
+	// and dropped, when it is stored with no frames, e.g. invalidated by a reorg
+	records = txRecords(txList[1:])
+	records[0].Traces = []*models.TransactionTrace{}
+	err = s.st.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+	r.Nil(err, "fail to upsert the transaction")
+
+	traceList, err = s.st.GetTransactionTraces(s.ctx, cmd.SepoliaChainID, []string{txList[1].TXHash})
+	r.Nil(err, "fail to get transaction traces")
+	r.Empty(traceList, "previous traces must be dropped")
+}
+
+func (s *StorageTestSuite) TestGetMyTokenTransfers() {
+	txList := mockEthereumTransactions()
+
+	r := s.Require()
+
+	user := mockUser(-7)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	// only the first transaction is saved by the user
+	records := txRecords(txList)
+	for _, record := range records {
+		record.Transfers = []*models.TokenTransfer{{
+			ChainID:      record.ChainID,
+			TXHash:       record.TXHash,
+			Standard:     "erc20",
+			TokenAddress: "0x4c16D8C078eF6B56700C1BE19a336915962df072",
+			FromAddress:  record.FromAddress,
+			ToAddress:    "0xAa449E0226B45D2044B1f721D04001fDe02ABb08",
+			Amount:       "1000",
+		}}
+	}
+	err = s.st.InsertTransactions(s.ctx, records[:1], user.ID)
+	r.Nil(err, "fail to insert transactions")
+	err = s.st.InsertTransactions(s.ctx, records[1:], store.NonAuthenticatedUser)
+	r.Nil(err, "fail to insert transactions")
+
+	transferList, err := s.st.GetMyTokenTransfers(s.ctx, cmd.SepoliaChainID, user.ID)
+	r.Nil(err, "fail to get my token transfers")
+	r.Len(transferList, 1)
+	r.Equal(txList[0].TXHash, transferList[0].TXHash)
+
+	transferList, err = s.st.GetTokenTransfers(s.ctx, cmd.SepoliaChainID, []string{txList[0].TXHash, txList[1].TXHash})
+	r.Nil(err, "fail to get token transfers")
+	r.Len(transferList, 2)
+}
+
+func (s *StorageTestSuite) TestAddWatchedAddress() {
+	r := s.Require()
+
+	user := mockUser(-8)
+	err := user.Insert(s.ctx, s.exec, boil.Infer())
+	r.Nil(err, "fail to insert user")
+
+	watched := &models.WatchedAddress{
+		UserID:  user.ID,
+		ChainID: cmd.SepoliaChainID,
+		Address: "0xaa449e0226b45d2044b1f721d04001fde02abb08",
+	}
+	r.Nil(s.st.AddWatchedAddress(s.ctx, watched), "fail to add watched address")
+	r.Nil(s.st.AddWatchedAddress(s.ctx, watched), "the same address can be added again")
+
+	watchedList, err := s.st.GetWatchedAddresses(s.ctx, cmd.SepoliaChainID)
+	r.Nil(err, "fail to get watched addresses")
+	r.True(slices.ContainsFunc(watchedList, func(w *models.WatchedAddress) bool {
+		return w.UserID == user.ID && w.Address == watched.Address
+	}), "watched address cannot be found")
+
+	watchedList, err = s.st.GetWatchedAddresses(s.ctx, 1)
+	r.Nil(err, "fail to get watched addresses")
+	r.False(slices.ContainsFunc(watchedList, func(w *models.WatchedAddress) bool { return w.UserID == user.ID }),
+		"the address is watched on another chain")
+}
+
+func (s *StorageTestSuite) TestSaveBackfillCheckpoint() {
+	r := s.Require()
+
+	const job = "0000000000000000000000000000000000000000000000000000000000000001"
+
+	checkpoint, err := s.st.GetBackfillCheckpoint(s.ctx, cmd.SepoliaChainID, job)
+	r.Nil(err, "fail to get backfill checkpoint")
+	r.Nil(checkpoint, "the job is not started yet")
+
+	for _, next := range []int64{100, 150} {
+		err = s.st.SaveBackfillCheckpoint(s.ctx, &models.BackfillCheckpoint{
+			ChainID:   cmd.SepoliaChainID,
+			Job:       job,
+			FromBlock: 100,
+			ToBlock:   200,
+			NextBlock: next,
+		})
+		r.Nil(err, "fail to save backfill checkpoint")
+	}
+
+	checkpoint, err = s.st.GetBackfillCheckpoint(s.ctx, cmd.SepoliaChainID, job)
+	r.Nil(err, "fail to get backfill checkpoint")
+	r.Equal(int64(150), checkpoint.NextBlock, "the last checkpoint must be kept")
+}
+
+func (s *StorageTestSuite) TestUpsertContract() {
+	r := s.Require()
+
+	const address = "0x4c16d8c078ef6b56700c1be19a336915962df072"
+
+	// unknown contracts have no abi
+	contract, err := s.st.GetContract(s.ctx, cmd.SepoliaChainID, address)
+	r.Nil(err, "fail to get the contract")
+	r.Nil(contract)
+
+	for _, abi := range []string{`[{"type":"function","name":"mint"}]`, `[{"type":"function","name":"burn"}]`} {
+		err = s.st.UpsertContract(s.ctx, &models.Contract{ChainID: cmd.SepoliaChainID, Address: address, Abi: abi})
+		r.Nil(err, "fail to upsert the contract")
+
+		contract, err = s.st.GetContract(s.ctx, cmd.SepoliaChainID, address)
+		r.Nil(err, "fail to get the contract")
+		r.Equal(abi, contract.Abi, "the latest abi must be stored")
+	}
+}
+
+func (s *StorageTestSuite) TestWithTxRollback() {
+	r := s.Require()
+
+	// the unit of work runs outside of the rolled back db transaction of the test, so it is not joined
+	records := MockBulkRecords(2, 10)
+	errFailed := errors.New("unit of work failed")
+	err := s.store.WithTx(s.ctx, func(tx store.StorageProvider) error {
+		if err := tx.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	r.ErrorIs(err, errFailed)
+
+	txList, err := s.store.GetTransactionsByHashes(s.ctx, cmd.SepoliaChainID, recordHashes(records))
+	r.Nil(err, "fail to get transactions")
+	r.Empty(txList, "the failed unit of work must be rolled back")
+}
+
+// TestConcurrentInserts runs the units of work of concurrent requests side by side on the shared store,
+// run it with -race
+func (s *StorageTestSuite) TestConcurrentInserts() {
+	r := s.Require()
+
+	const workers = 8
+	batches := make([][]*store.TxRecord, workers)
+	for i := range batches {
+		batches[i] = MockBulkRecords(100+i, 20)
+	}
+	// the units of work are committed, so the test cleans up after itself
+	defer func() {
+		for _, records := range batches {
+			DeleteRecords(s.ctx, s.Backend.Executor(), records)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i, records := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i%2 == 0 {
+				errs <- s.store.InsertTransactions(s.ctx, records, store.NonAuthenticatedUser)
+				return
+			}
+			// the odd workers insert the records one by one, all of them within a single unit of work
+			errs <- s.store.WithTx(s.ctx, func(tx store.StorageProvider) error {
+				for _, record := range records {
+					err := tx.InsertTransactions(s.ctx, []*store.TxRecord{record}, store.NonAuthenticatedUser)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		r.Nil(err, "fail to insert transactions concurrently")
+	}
+	for _, records := range batches {
+		txList, err := s.store.GetTransactionsByHashes(s.ctx, cmd.SepoliaChainID, recordHashes(records))
+		r.Nil(err, "fail to get transactions")
+		r.Len(txList, len(records))
+	}
+}
+
+// Run runs the suite against the storage backend
+func Run(t *testing.T, backend Backend) {
+	suite.Run(t, &StorageTestSuite{Backend: backend})
+}
+
+func containsTransactions(allList, txList []*models.Transaction) int {
+	// find all the inserted transactions
+	foundCnt := 0
+	_ = slices.ContainsFunc(allList, func(tx *models.Transaction) bool {
+		for _, v := range txList {
+			if tx.TXHash == v.TXHash {
+				foundCnt++
+			}
+		}
+		return false
+	})
+	return foundCnt
+}
+
+func txHashes(txList []*models.Transaction) []string {
+	var hashes []string
+	for _, tx := range txList {
+		hashes = append(hashes, tx.TXHash)
+	}
+	return hashes
+}
+
+func recordHashes(records []*store.TxRecord) []string {
+	hashes := make([]string, 0, len(records))
+	for _, record := range records {
+		hashes = append(hashes, record.TXHash)
+	}
+	return hashes
+}
+
+func txRecords(txList []*models.Transaction) []*store.TxRecord {
+	records := make([]*store.TxRecord, 0, len(txList))
+	for _, tx := range txList {
+		record := &store.TxRecord{Transaction: tx}
+		if tx.BlockHash.Valid {
+			record.Block = mockBlock(tx)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// mockBlock returns the block of the mined transaction, with a timestamp of 12 seconds per block
+func mockBlock(tx *models.Transaction) *models.Block {
+	number := new(big.Int)
+	tx.BlockNumber.Int(number)
+
+	return &models.Block{
+		ChainID:     tx.ChainID,
+		BlockHash:   tx.BlockHash.String,
+		BlockNumber: types.NewDecimal(tx.BlockNumber.Big),
+		ParentHash:  "0x0000000000000000000000000000000000000000000000000000000000000000",
+		Timestamp:   time.Unix(number.Int64()*12, 0).UTC(),
+		Miner:       "0x0000000000000000000000000000000000000000",
+	}
+}
+
+func mockTransactionLogs(tx *models.Transaction) []*models.TransactionLog {
+	return []*models.TransactionLog{{
+		ChainID:  tx.ChainID,
+		TXHash:   tx.TXHash,
+		LogIndex: 0,
+		Address:  "0x4c16D8C078eF6B56700C1BE19a336915962df072",
+		Topics: types.StringArray{
+			"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			"0x0000000000000000000000000000000000000000000000000000000000000000",
+			"0x000000000000000000000000d5e6f34bbd4251195c03e7bf3660677ed2315f70",
+		},
+		Data: "0x",
+	}}
+}
+
+func mockTransactionTraces(tx *models.Transaction) []*models.TransactionTrace {
+	return []*models.TransactionTrace{
+		{
+			ChainID:     tx.ChainID,
+			TXHash:      tx.TXHash,
+			TraceIndex:  0,
+			CallType:    "CALL",
+			FromAddress: "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
+			ToAddress:   null.StringFrom("0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
+			Value:       "0",
+			Gas:         100000,
+			Depth:       0,
+		},
+		{
+			ChainID:     tx.ChainID,
+			TXHash:      tx.TXHash,
+			TraceIndex:  1,
+			CallType:    "CALL",
+			FromAddress: "0xAa449E0226B45D2044B1f721D04001fDe02ABb08",
+			ToAddress:   null.StringFrom("0xd5e6f34bbd4251195c03e7bf3660677ed2315f70"),
+			Value:       "1000",
+			Gas:         2300,
+			Depth:       1,
+		},
+	}
+}
+
+func mockUser(userID int) *models.User {
+	user := &models.User{
+		ID:       userID,
+		Username: gofakeit.Username(),
+		Password: gofakeit.Password(true, true, true, false, false, 10),
+	}
+	return user
+}
+
+func mockEthereumTransactions() []*models.Transaction {
+	txList := []*models.Transaction{{
+		ChainID:   cmd.SepoliaChainID,
+		TXHash:    "0x11113f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df71111",
+		TXStatus:  null.IntFrom(1),
+		BlockHash: null.StringFrom("0x61914f9b5d11dcf30b943f9b6adf4d1c965f31de9157094ec2c51714cb505577"),
+		BlockNumber: types.NullDecimal{
+			Big: decimal.New(5703601, 0),
+		},
+		FromAddress:     "0x1fc35B79FB11Ea7D4532dA128DfA9Db573C51b09",
+		ToAddress:       null.StringFrom("0xAa449E0226B45D2044B1f721D04001fDe02ABb08"),
+		ContractAddress: null.String{},
+		LogsCount:       0,
+		Input:           "0x",
+		Value:           "500000000000000000",
+	},
+		{
+			ChainID:   cmd.SepoliaChainID,
+			TXHash:    "0x22223f7adff7fbfc2a10b22a6710331ee68f2e4d1cd73a584d57c8821df72222",
+			TXStatus:  null.IntFrom(1),
+			BlockHash: null.StringFrom("0xc5a3664f031da2458646a01e18e6957fd1f43715524d94b7336a004b5635837d"),
+			BlockNumber: types.NullDecimal{
+				Big: decimal.New(5702816, 0),
+			},
+			FromAddress:     "0xd5e6f34bBd4251195c03e7Bf3660677Ed2315f70",
+			ToAddress:       null.StringFrom("0x4c16D8C078eF6B56700C1BE19a336915962df072"),
+			ContractAddress: null.String{},
+			LogsCount:       1,
+			Input:           "0x6a627842000000000000000000000000d5e6f34bbd4251195c03e7bf3660677ed2315f70",
+			Value:           "0",
+		},
+	}
+	return txList
+}
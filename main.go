@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"ethereum-fetcher/cmd"
+	"ethereum-fetcher/internal/app"
 	"ethereum-fetcher/internal/di"
 	"ethereum-fetcher/internal/server"
 
@@ -23,21 +24,55 @@ func main() {
 		log.Fatalf("cannot initialize dependencies: %v", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == cmd.BackfillCommand {
+		runBackfill(container)
+		return
+	}
+
 	err = container.Invoke(func(vp *viper.Viper, cancel context.CancelFunc, limeAPIProvider *server.WebServer) {
 		cmd.LogInit(vp.GetString(cmd.LogLevel))
 
 		log.WithFields(log.Fields{
-			"status": "starting",
-			"port":   vp.GetString(cmd.APIPort),
-			"pid":    os.Getpid(),
+			"status":     "starting",
+			"port":       vp.GetString(cmd.APIPort),
+			"admin_port": vp.GetString(cmd.AdminPort),
+			"pid":        os.Getpid(),
 		}).Info("lime ethereum fetcher server")
 
 		cmd.InitShutdownHandler(cancel)
 
-		limeAPIProvider.Run(vp.GetInt(cmd.APIPort))
+		limeAPIProvider.Run(vp.GetInt(cmd.APIPort), vp.GetInt(cmd.AdminPort))
 		log.Info("nuit, nuit")
 	})
 	if err != nil {
 		log.Fatalf("cannot invoke dependencies: %v", err)
 	}
 }
+
+// runBackfill stores the past transactions of the block range, provided by the command line arguments
+func runBackfill(container *dig.Container) {
+	opts, err := cmd.ParseBackfillArgs(os.Args[2:])
+	if err != nil {
+		log.Fatalf("invalid backfill arguments: %v", err)
+	}
+
+	err = container.Invoke(func(vp *viper.Viper, cancel context.CancelFunc, backfiller app.Backfiller) {
+		cmd.LogInit(vp.GetString(cmd.LogLevel))
+		cmd.InitShutdownHandler(cancel)
+
+		log.WithFields(log.Fields{
+			"status": "starting",
+			"from":   opts.FromBlock,
+			"to":     opts.ToBlock,
+			"pid":    os.Getpid(),
+		}).Info("lime ethereum fetcher backfill")
+
+		if err := backfiller.Backfill(opts); err != nil {
+			log.Fatalf("backfill failed: %v", err)
+		}
+		log.Info("backfill done")
+	})
+	if err != nil {
+		log.Fatalf("cannot invoke dependencies: %v", err)
+	}
+}